@@ -0,0 +1,91 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// maxBaggageSize is the W3C limit of the encoded baggage header, beyond which receivers may
+// drop the whole header.
+const maxBaggageSize = 8192
+
+type resourceBaggage struct {
+	propagation.TextMapPropagator
+	members []baggage.Member
+}
+
+// WithResourceBaggage wraps a propagator so that the given resource attributes, e.g.
+// deployment.environment, are injected as baggage members of the same name, so that downstream
+// services receive them. A member already carried by the context is left unchanged, so the values
+// of the service at the root of the trace win. Members that would make the encoded baggage exceed
+// the W3C limit of 8192 bytes are not injected. Setup uses it for the
+// OTLPConfigs.ResourceBaggageAttributes attributes.
+//
+// Every member is sent on every outgoing request, so the list should stay short: request headers
+// are commonly limited to a few kilobytes by proxies, shared with the application baggage.
+//
+// Parameters:
+//   - propagator: The propagator, which must propagate baggage for the members to be injected
+//   - res: The resource the attribute values are read from, missing attributes being skipped
+//   - keys: The resource attributes injected as baggage
+//
+// Returns:
+//   - propagation.TextMapPropagator: The propagator injecting the resource baggage
+//   - error: An error if an attribute isn't a valid baggage key or the members exceed the limit
+func WithResourceBaggage(propagator propagation.TextMapPropagator, res *resource.Resource, keys ...attribute.Key) (propagation.TextMapPropagator, error) {
+	members := make([]baggage.Member, 0, len(keys))
+	for _, key := range keys {
+		value, ok := res.Set().Value(key)
+		if !ok {
+			continue
+		}
+
+		member, err := baggage.NewMemberRaw(string(key), value.Emit())
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource baggage attribute %q: %w", key, err)
+		}
+		members = append(members, member)
+	}
+
+	if len(members) == 0 {
+		return propagator, nil
+	}
+
+	if _, err := baggage.New(members...); err != nil {
+		return nil, fmt.Errorf("invalid resource baggage: %w", err)
+	}
+
+	return &resourceBaggage{TextMapPropagator: propagator, members: members}, nil
+}
+
+func (p *resourceBaggage) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	bag := baggage.FromContext(ctx)
+
+	seeded := false
+	for _, member := range p.members {
+		if bag.Member(member.Key()).Key() != "" {
+			continue
+		}
+
+		next, err := bag.SetMember(member)
+		if err != nil || len(next.String()) > maxBaggageSize {
+			continue
+		}
+		bag, seeded = next, true
+	}
+
+	if seeded {
+		ctx = baggage.ContextWithBaggage(ctx, bag)
+	}
+
+	p.TextMapPropagator.Inject(ctx, carrier)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package clock provides the time abstraction used by the time-dependent components of
+// this module (samplers, processors and exporters), so that tests can control time
+// deterministically instead of relying on real sleeps.
+//
+// Real is backed by the time package and Fake is a manually advanced clock for tests.
+// Note that the OpenTelemetry SDK batch span processor schedules its exports with its own
+// timers; tests relying on it should call ForceFlush rather than advancing a Fake clock.
+package clock
+
+import (
+	"time"
+)
+
+// Clock provides the current time and creates timers and tickers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+	// NewTimer creates a Timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+	// NewTicker creates a Ticker that fires every d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is the Clock counterpart of time.Timer.
+type Timer interface {
+	// Chan returns the channel on which the time is delivered when the timer fires.
+	Chan() <-chan time.Time
+	// Stop prevents the timer from firing, reporting whether it was active.
+	Stop() bool
+	// Reset changes the timer to fire after d, reporting whether it was active.
+	Reset(d time.Duration) bool
+}
+
+// Ticker is the Clock counterpart of time.Ticker.
+type Ticker interface {
+	// Chan returns the channel on which the ticks are delivered.
+	Chan() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+type realClock struct{}
+
+// Real returns the Clock backed by the time package.
+func Real() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return &realTimer{time.NewTimer(d)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{time.NewTicker(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t *realTimer) Chan() <-chan time.Time {
+	return t.C
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t *realTicker) Chan() <-chan time.Time {
+	return t.C
+}
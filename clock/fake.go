@@ -0,0 +1,142 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Fake is a Clock whose time only moves when Advance is called. Timers and tickers
+// created from it fire synchronously from Advance once their deadline is reached.
+// It is intended for tests. Only the active timers and tickers are tracked, the stopped
+// and fired ones being released.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	clock    *Fake
+	c        chan time.Time
+	deadline time.Time
+	period   time.Duration
+	active   bool
+}
+
+// NewFake creates a Fake clock set to the given time.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the current fake time.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Since returns the fake time elapsed since t.
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// NewTimer creates a Timer firing once the fake time has advanced by d.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	return f.newWaiter(d, 0)
+}
+
+// NewTicker creates a Ticker firing every time the fake time advances by d.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	return &fakeTicker{f.newWaiter(d, d)}
+}
+
+// Advance moves the fake time forward by d, firing every timer and ticker whose deadline
+// is reached. As with the time package, a tick is dropped if the previous one was not consumed.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+	active := f.waiters[:0]
+	for _, w := range f.waiters {
+		for w.active && !w.deadline.After(f.now) {
+			select {
+			case w.c <- w.deadline:
+			default:
+			}
+
+			if w.period == 0 {
+				w.active = false
+				break
+			}
+			w.deadline = w.deadline.Add(w.period)
+		}
+
+		if w.active {
+			active = append(active, w)
+		}
+	}
+	clear(f.waiters[len(active):])
+	f.waiters = active
+}
+
+func (f *Fake) newWaiter(d, period time.Duration) *fakeWaiter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{
+		clock:    f,
+		c:        make(chan time.Time, 1),
+		deadline: f.now.Add(d),
+		period:   period,
+		active:   true,
+	}
+	f.waiters = append(f.waiters, w)
+
+	return w
+}
+
+func (w *fakeWaiter) Chan() <-chan time.Time {
+	return w.c
+}
+
+func (w *fakeWaiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	active := w.active
+	w.active = false
+	w.clock.waiters = slices.DeleteFunc(w.clock.waiters, func(other *fakeWaiter) bool {
+		return other == w
+	})
+
+	return active
+}
+
+func (w *fakeWaiter) Reset(d time.Duration) bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	active := w.active
+	w.deadline = w.clock.now.Add(d)
+	w.active = true
+	if !active {
+		w.clock.waiters = append(w.clock.waiters, w)
+	}
+
+	return active
+}
+
+type fakeTicker struct {
+	*fakeWaiter
+}
+
+func (t *fakeTicker) Stop() {
+	t.fakeWaiter.Stop()
+}
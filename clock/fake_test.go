@@ -0,0 +1,78 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func (f *Fake) waiting() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.waiters)
+}
+
+func TestFakeTimerFires(t *testing.T) {
+	f := NewFake(time.Unix(0, 0))
+	timer := f.NewTimer(time.Second)
+
+	f.Advance(999 * time.Millisecond)
+	select {
+	case <-timer.Chan():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	f.Advance(time.Millisecond)
+	select {
+	case got := <-timer.Chan():
+		if want := time.Unix(1, 0); !got.Equal(want) {
+			t.Errorf("timer fired at %v, want %v", got, want)
+		}
+	default:
+		t.Fatal("timer did not fire at its deadline")
+	}
+}
+
+func TestFakeReleasesStoppedAndFiredWaiters(t *testing.T) {
+	f := NewFake(time.Unix(0, 0))
+
+	fired := f.NewTimer(time.Second)
+	stopped := f.NewTimer(time.Minute)
+	ticker := f.NewTicker(time.Second)
+	if got := f.waiting(); got != 3 {
+		t.Fatalf("waiters = %d, want 3", got)
+	}
+
+	if !stopped.Stop() {
+		t.Error("Stop() of an active timer = false, want true")
+	}
+	f.Advance(time.Second)
+	<-fired.Chan()
+	<-ticker.Chan()
+	if got := f.waiting(); got != 1 {
+		t.Errorf("waiters after a timer fired and another stopped = %d, want the ticker only", got)
+	}
+
+	ticker.Stop()
+	if got := f.waiting(); got != 0 {
+		t.Errorf("waiters after the ticker stopped = %d, want 0", got)
+	}
+
+	if fired.Reset(time.Second) {
+		t.Error("Reset() of a fired timer = true, want false")
+	}
+	if got := f.waiting(); got != 1 {
+		t.Errorf("waiters after a fired timer was reset = %d, want 1", got)
+	}
+	f.Advance(time.Second)
+	select {
+	case <-fired.Chan():
+	default:
+		t.Error("reset timer did not fire at its new deadline")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+)
+
+// Verbosity levels used by the OpenTelemetry SDK internal logger.
+const (
+	sdkWarnVerbosity  = 1
+	sdkInfoVerbosity  = 4
+	sdkDebugVerbosity = 8
+)
+
+// diagnostics is the logger of the warnings emitted by this package helpers,
+// the SDK logger once Setup configured it.
+var diagnostics atomic.Pointer[logr.Logger]
+
+// setSDKLogger sets the OpenTelemetry SDK logger and uses it for the package diagnostics.
+func setSDKLogger(logger logr.Logger) {
+	otel.SetLogger(logger)
+	diagnostics.Store(&logger)
+}
+
+// warn logs a warning on the package diagnostics logger, if any.
+func warn(msg string, keysAndValues ...any) {
+	if logger := diagnostics.Load(); logger != nil {
+		logger.V(sdkWarnVerbosity).Info(msg, keysAndValues...)
+	}
+}
+
+// sdkLogSink is a logr.LogSink writing the OpenTelemetry SDK internal logs
+// (errors, warnings such as exceeded attribute limits, info and debug messages)
+// to the application zap logger.
+type sdkLogSink struct {
+	logger    *zap.Logger
+	verbosity int
+}
+
+// newSDKLogger creates the logr.Logger given to otel.SetLogger. The level is one of
+// "error", "warn", "info" or "debug" and defaults to "warn".
+func newSDKLogger(logger *zap.Logger, level string) (logr.Logger, error) {
+	verbosity, err := sdkVerbosity(level)
+	if err != nil {
+		return logr.Logger{}, err
+	}
+
+	return logr.New(&sdkLogSink{logger: logger.Named("otel"), verbosity: verbosity}), nil
+}
+
+func sdkVerbosity(level string) (int, error) {
+	switch strings.ToLower(level) {
+	case "error":
+		return 0, nil
+	case "", "warn":
+		return sdkWarnVerbosity, nil
+	case "info":
+		return sdkInfoVerbosity, nil
+	case "debug":
+		return sdkDebugVerbosity, nil
+	default:
+		return 0, fmt.Errorf("invalid otel sdk log level %q", level)
+	}
+}
+
+func (s *sdkLogSink) Init(logr.RuntimeInfo) {}
+
+func (s *sdkLogSink) Enabled(level int) bool {
+	return level <= s.verbosity
+}
+
+func (s *sdkLogSink) Info(level int, msg string, keysAndValues ...any) {
+	fields := zapFields(keysAndValues)
+
+	switch {
+	case level <= sdkWarnVerbosity:
+		s.logger.Warn(msg, fields...)
+	case level <= sdkInfoVerbosity:
+		s.logger.Info(msg, fields...)
+	default:
+		s.logger.Debug(msg, fields...)
+	}
+}
+
+func (s *sdkLogSink) Error(err error, msg string, keysAndValues ...any) {
+	s.logger.Error(msg, append(zapFields(keysAndValues), zap.Error(err))...)
+}
+
+func (s *sdkLogSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &sdkLogSink{logger: s.logger.With(zapFields(keysAndValues)...), verbosity: s.verbosity}
+}
+
+func (s *sdkLogSink) WithName(name string) logr.LogSink {
+	return &sdkLogSink{logger: s.logger.Named(name), verbosity: s.verbosity}
+}
+
+func zapFields(keysAndValues []any) []zap.Field {
+	fields := make([]zap.Field, 0, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields = append(fields, zap.Any(fmt.Sprint(keysAndValues[i]), keysAndValues[i+1]))
+	}
+
+	return fields
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ErrAbandoned is returned by the export calls of an Abandonable exporter once abandoned.
+var ErrAbandoned = errors.New("span export abandoned")
+
+// Abandonable is a SpanExporter whose pending and future export calls can be abandoned,
+// e.g. when the shutdown deadline expires while the collector is unreachable.
+type Abandonable struct {
+	sdktrace.SpanExporter
+
+	abandoned context.Context
+	abandon   context.CancelFunc
+}
+
+// NewAbandonable wraps a SpanExporter so that its export calls can be abandoned with Abandon.
+//
+// Parameters:
+//   - exporter: The exporter to be wrapped
+//
+// Returns:
+//   - *Abandonable: The abandonable exporter
+func NewAbandonable(exporter sdktrace.SpanExporter) *Abandonable {
+	abandoned, abandon := context.WithCancel(context.Background())
+	return &Abandonable{SpanExporter: exporter, abandoned: abandoned, abandon: abandon}
+}
+
+// ExportSpans exports the spans through the wrapped exporter, unless the exporter was abandoned,
+// in which case the spans are dropped and ErrAbandoned is returned. An export call in flight
+// when Abandon is called has its context canceled.
+func (e *Abandonable) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.abandoned.Err() != nil {
+		return ErrAbandoned
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(e.abandoned, cancel)
+	defer stop()
+
+	return e.SpanExporter.ExportSpans(ctx, spans)
+}
+
+// Shutdown shuts down the wrapped exporter, unless the exporter was abandoned.
+func (e *Abandonable) Shutdown(ctx context.Context) error {
+	if e.abandoned.Err() != nil {
+		return ErrAbandoned
+	}
+
+	return e.SpanExporter.Shutdown(ctx)
+}
+
+// Abandon cancels the export call in flight and makes every following call return immediately.
+func (e *Abandonable) Abandon() {
+	e.abandon()
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type batchContext struct {
+	sdktrace.SpanExporter
+	contextFor func(ctx context.Context, spans []sdktrace.ReadOnlySpan) context.Context
+}
+
+// NewBatchContext wraps a SpanExporter so that each export call receives the context returned by
+// contextFor for the exported batch, e.g. carrying the tenant of its spans. Combined with
+// otlpgrpc.Options.HeaderProvider, it lets headers such as x-tenant-id vary per export call,
+// which helps routing exports in multi-tenant collector setups. Since a call exports a whole
+// batch, the context can only describe the batch, not each of its spans.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports
+//   - contextFor: Returns the context of the export call of a batch, derived from ctx
+//
+// Returns:
+//   - sdktrace.SpanExporter: The exporter
+func NewBatchContext(exporter sdktrace.SpanExporter, contextFor func(ctx context.Context, spans []sdktrace.ReadOnlySpan) context.Context) sdktrace.SpanExporter {
+	return &batchContext{SpanExporter: exporter, contextFor: contextFor}
+}
+
+func (e *batchContext) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	return e.SpanExporter.ExportSpans(e.contextFor(ctx, spans), spans)
+}
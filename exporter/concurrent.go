@@ -0,0 +1,137 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type concurrent struct {
+	sdktrace.SpanExporter
+
+	timeout time.Duration
+	slots   chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewConcurrent wraps a SpanExporter so that up to maxConcurrent export calls are in flight
+// at the same time. ExportSpans hands the batch to a background export and returns as soon
+// as a slot is available, letting the batch span processor assemble the next batch while
+// previous ones are still being exported. Export errors are reported to the global
+// OpenTelemetry error handler, since they can no longer be returned to the caller.
+//
+// Concurrency relaxes ordering: batches may reach the collector in a different order than
+// they were produced, and a TracerProvider.ForceFlush may return before the last batches are
+// exported. Wait and Shutdown wait for the exports in flight.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports
+//   - maxConcurrent: The maximum number of export calls in flight
+//   - timeout: The timeout of each background export call
+//
+// Returns:
+//   - sdktrace.SpanExporter: The concurrent exporter
+func NewConcurrent(exporter sdktrace.SpanExporter, maxConcurrent int, timeout time.Duration) sdktrace.SpanExporter {
+	if maxConcurrent <= 1 {
+		return exporter
+	}
+
+	return &concurrent{
+		SpanExporter: exporter,
+		timeout:      timeout,
+		slots:        make(chan struct{}, maxConcurrent),
+	}
+}
+
+func (e *concurrent) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	select {
+	case e.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// The batch span processor reuses its batch slice once ExportSpans returns.
+	batch := slices.Clone(spans)
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer func() { <-e.slots }()
+
+		exportCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), e.timeout)
+		defer cancel()
+
+		if err := e.SpanExporter.ExportSpans(exportCtx, batch); err != nil {
+			otel.Handle(err)
+		}
+	}()
+
+	return nil
+}
+
+func (e *concurrent) Shutdown(ctx context.Context) error {
+	if err := e.wait(ctx); err != nil {
+		return err
+	}
+
+	return e.SpanExporter.Shutdown(ctx)
+}
+
+func (e *concurrent) wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Wait blocks until the background exports in flight of an exporter created by NewConcurrent
+// have returned, or until ctx is done. It returns immediately for other exporters, whose
+// ExportSpans calls return once the export is done.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//   - exporter: The exporter returned by NewConcurrent
+//
+// Returns:
+//   - error: The ctx error if it is done before the exports return
+func Wait(ctx context.Context, exporter sdktrace.SpanExporter) error {
+	e, ok := exporter.(*concurrent)
+	if !ok {
+		return nil
+	}
+
+	// Holding every slot guarantees that no export is in flight.
+	acquired := 0
+	defer func() {
+		for range acquired {
+			<-e.slots
+		}
+	}()
+
+	for range cap(e.slots) {
+		select {
+		case e.slots <- struct{}{}:
+			acquired++
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// benchmarkBatch returns a batch of n sampled spans carrying a few attributes.
+func benchmarkBatch(n int) []sdktrace.ReadOnlySpan {
+	stubs := make(tracetest.SpanStubs, n)
+	for i := range stubs {
+		stubs[i] = tracetest.SpanStub{
+			Name: "GET /users/{id}",
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{byte(i)},
+				SpanID:     trace.SpanID{byte(i)},
+				TraceFlags: trace.FlagsSampled,
+			}),
+			StartTime: time.Unix(0, 0),
+			EndTime:   time.Unix(1, 0),
+			Attributes: []attribute.KeyValue{
+				attribute.String("http.request.method", "GET"),
+				attribute.String("http.route", "/users/{id}"),
+				attribute.Int("http.response.status_code", 200),
+			},
+		}
+	}
+	return stubs.Snapshots()
+}
+
+// benchmarkExportSpans exports a 512 span batch per iteration to exp.
+func benchmarkExportSpans(b *testing.B, exp sdktrace.SpanExporter) {
+	b.Helper()
+
+	batch := benchmarkBatch(512)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := exp.ExportSpans(ctx, batch); err != nil {
+			b.Fatalf("ExportSpans() error = %v, want nil", err)
+		}
+	}
+
+	if err := Wait(ctx, exp); err != nil {
+		b.Fatalf("Wait() error = %v, want nil", err)
+	}
+}
+
+func BenchmarkConcurrentExportSpans(b *testing.B) {
+	benchmarkExportSpans(b, NewConcurrent(NewDiscard(), 4, time.Second))
+}
+
+// BenchmarkExportChain exports through the wrappers stacked by otel.Setup.
+func BenchmarkExportChain(b *testing.B) {
+	sizeLimited, err := NewSizeLimited(NewDiscard(), 64*1024, OversizedTruncate)
+	if err != nil {
+		b.Fatalf("NewSizeLimited() error = %v, want nil", err)
+	}
+
+	benchmarkExportSpans(b, NewConcurrent(NewObserved(sizeLimited), 4, time.Second))
+}
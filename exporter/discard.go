@@ -0,0 +1,70 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type discard struct{}
+
+// NewDiscard creates a SpanExporter dropping every span, standing in for an exporter that couldn't
+// be created so that the tracer provider keeps working, e.g. until a Swappable replaces it.
+//
+// Returns:
+//   - sdktrace.SpanExporter: The discarding exporter
+func NewDiscard() sdktrace.SpanExporter {
+	return discard{}
+}
+
+func (discard) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error { return nil }
+
+func (discard) Shutdown(context.Context) error { return nil }
+
+type discardMetric struct{}
+
+// NewDiscardMetric creates a metric Exporter dropping every metric as NewDiscard. It uses the
+// default temporality and aggregation, the ones of the OTLP exporters.
+//
+// Returns:
+//   - sdkmetric.Exporter: The discarding exporter
+func NewDiscardMetric() sdkmetric.Exporter {
+	return discardMetric{}
+}
+
+func (discardMetric) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(kind)
+}
+
+func (discardMetric) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+func (discardMetric) Export(context.Context, *metricdata.ResourceMetrics) error { return nil }
+
+func (discardMetric) ForceFlush(context.Context) error { return nil }
+
+func (discardMetric) Shutdown(context.Context) error { return nil }
+
+type discardLog struct{}
+
+// NewDiscardLog creates a log Exporter dropping every log record as NewDiscard.
+//
+// Returns:
+//   - sdklog.Exporter: The discarding exporter
+func NewDiscardLog() sdklog.Exporter {
+	return discardLog{}
+}
+
+func (discardLog) Export(context.Context, []sdklog.Record) error { return nil }
+
+func (discardLog) ForceFlush(context.Context) error { return nil }
+
+func (discardLog) Shutdown(context.Context) error { return nil }
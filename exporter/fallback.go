@@ -0,0 +1,118 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// DefaultFallbackThreshold is the number of consecutive primary failures after which
+	// the primary exporter is considered persistently unreachable.
+	DefaultFallbackThreshold = 3
+	// DefaultFallbackCooldown is how long exports go straight to the secondary exporter once
+	// the primary one is considered persistently unreachable, before the primary is retried.
+	DefaultFallbackCooldown = time.Minute
+	// FallbackPrimaryShare is the share of the remaining export deadline given to the primary
+	// exporter, the secondary one getting what is left when the primary fails.
+	FallbackPrimaryShare = 0.5
+)
+
+type fallback struct {
+	primary   sdktrace.SpanExporter
+	secondary sdktrace.SpanExporter
+	clock     clock.Clock
+
+	mu            sync.Mutex
+	failures      int
+	fallbackUntil time.Time
+}
+
+// NewFallback creates a SpanExporter sending spans to the primary exporter and falling back to
+// the secondary one when the primary export fails, e.g. an OTLP/gRPC exporter blocked by a
+// firewall falling back to OTLP/HTTP.
+//
+// After DefaultFallbackThreshold consecutive primary failures, the primary exporter is considered
+// persistently unreachable and is skipped for DefaultFallbackCooldown, so that exports don't pay
+// the primary timeout on every batch. The primary exporter is tried again once the cooldown ends.
+//
+// When the export context has a deadline, the primary exporter is only given FallbackPrimaryShare
+// of the remaining time, so that a primary retrying or waiting for an unreachable endpoint until
+// the deadline doesn't leave the secondary exporter with an expired context.
+//
+// Parameters:
+//   - primary: The preferred exporter
+//   - secondary: The exporter used when the primary one fails
+//   - clk: The clock used to measure the cooldown
+//
+// Returns:
+//   - sdktrace.SpanExporter: The fallback exporter
+func NewFallback(primary, secondary sdktrace.SpanExporter, clk clock.Clock) sdktrace.SpanExporter {
+	return &fallback{primary: primary, secondary: secondary, clock: clk}
+}
+
+func (e *fallback) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.inCooldown() {
+		return e.secondary.ExportSpans(ctx, spans)
+	}
+
+	primaryErr := e.exportPrimary(ctx, spans)
+	e.record(primaryErr)
+	if primaryErr == nil {
+		return nil
+	}
+
+	if err := e.secondary.ExportSpans(ctx, spans); err != nil {
+		return errors.Join(primaryErr, err)
+	}
+
+	return nil
+}
+
+// exportPrimary exports the spans with the primary exporter within its share of the deadline.
+func (e *fallback) exportPrimary(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		share := time.Duration(float64(time.Until(deadline)) * FallbackPrimaryShare)
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, share)
+		defer cancel()
+	}
+
+	return e.primary.ExportSpans(ctx, spans)
+}
+
+func (e *fallback) Shutdown(ctx context.Context) error {
+	return errors.Join(e.primary.Shutdown(ctx), e.secondary.Shutdown(ctx))
+}
+
+func (e *fallback) inCooldown() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.clock.Now().Before(e.fallbackUntil)
+}
+
+func (e *fallback) record(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err == nil {
+		e.failures = 0
+		return
+	}
+
+	e.failures++
+	if e.failures >= DefaultFallbackThreshold {
+		e.failures = 0
+		e.fallbackUntil = e.clock.Now().Add(DefaultFallbackCooldown)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// blackhole is a SpanExporter blocking each export until its context is done, as an exporter
+// waiting for an unreachable endpoint does.
+type blackhole struct{}
+
+func (blackhole) ExportSpans(ctx context.Context, _ []sdktrace.ReadOnlySpan) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (blackhole) Shutdown(context.Context) error { return nil }
+
+// recorder is an in-memory SpanExporter failing the exports whose context is done, as an exporter
+// sending requests does.
+type recorder struct {
+	*tracetest.InMemoryExporter
+}
+
+func newRecorder() recorder {
+	return recorder{InMemoryExporter: tracetest.NewInMemoryExporter()}
+}
+
+func (r recorder) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return r.InMemoryExporter.ExportSpans(ctx, spans)
+}
+
+func TestFallbackExportsToSecondaryWhenPrimaryIsBlackholed(t *testing.T) {
+	secondary := newRecorder()
+	e := NewFallback(blackhole{}, secondary, clock.Real())
+
+	spans := tracetest.SpanStubs{{Name: "checkout"}}.Snapshots()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	if err := e.ExportSpans(ctx, spans); err != nil {
+		t.Fatalf("ExportSpans() error = %v, want nil", err)
+	}
+
+	got := secondary.GetSpans()
+	if len(got) != 1 || got[0].Name != "checkout" {
+		t.Fatalf("secondary received %v, want the checkout span", got)
+	}
+}
+
+func TestFallbackSkipsPrimaryDuringCooldown(t *testing.T) {
+	secondary := newRecorder()
+	clk := clock.NewFake(time.Now())
+	e := NewFallback(blackhole{}, secondary, clk)
+
+	spans := tracetest.SpanStubs{{Name: "checkout"}}.Snapshots()
+
+	for range DefaultFallbackThreshold {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		_ = e.ExportSpans(ctx, spans)
+		cancel()
+	}
+
+	// The primary would block forever without a deadline, so this export returning proves the
+	// primary is skipped.
+	if err := e.ExportSpans(context.Background(), spans); err != nil {
+		t.Fatalf("ExportSpans() error = %v, want nil", err)
+	}
+
+	if got := len(secondary.GetSpans()); got != DefaultFallbackThreshold+1 {
+		t.Fatalf("secondary received %d spans, want %d", got, DefaultFallbackThreshold+1)
+	}
+}
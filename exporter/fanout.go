@@ -0,0 +1,62 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type fanout struct {
+	exporters []sdktrace.SpanExporter
+}
+
+// NewFanout creates a SpanExporter duplicating each export call to every given exporter, e.g. to
+// dual-ship traces to a vendor and to an internal archive during a vendor migration. The exporters
+// are called concurrently, so a failing backend doesn't prevent the others from receiving the
+// spans; the call returns once all of them are done, with their errors joined.
+//
+// Parameters:
+//   - exporters: The exporters every export call is sent to, at least one must be given
+//
+// Returns:
+//   - sdktrace.SpanExporter: The fan-out exporter
+func NewFanout(exporters ...sdktrace.SpanExporter) sdktrace.SpanExporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+
+	return &fanout{exporters: exporters}
+}
+
+func (e *fanout) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	errs := make([]error, len(e.exporters))
+
+	var wg sync.WaitGroup
+	for i, exporter := range e.exporters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = exporter.ExportSpans(ctx, spans)
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (e *fanout) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, exporter := range e.exporters {
+		if err := exporter.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
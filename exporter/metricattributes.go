@@ -0,0 +1,159 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type metricAttributes struct {
+	sdkmetric.Exporter
+	attrs []attribute.KeyValue
+}
+
+// NewMetricAttributes wraps a metric Exporter so that the given attributes are added to every
+// data point it exports, measurement attributes with the same keys taking precedence. Metric views
+// can only filter attributes, so static metric attributes are added at export time instead.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports
+//   - attrs: The attributes added to every data point
+//
+// Returns:
+//   - sdkmetric.Exporter: The exporter
+func NewMetricAttributes(exporter sdkmetric.Exporter, attrs ...attribute.KeyValue) sdkmetric.Exporter {
+	if len(attrs) == 0 {
+		return exporter
+	}
+
+	return &metricAttributes{Exporter: exporter, attrs: attrs}
+}
+
+func (e *metricAttributes) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	for i := range rm.ScopeMetrics {
+		for j := range rm.ScopeMetrics[i].Metrics {
+			m := &rm.ScopeMetrics[i].Metrics[j]
+			m.Data = e.withAttributes(m.Data)
+		}
+	}
+
+	return e.Exporter.Export(ctx, rm)
+}
+
+func (e *metricAttributes) withAttributes(data metricdata.Aggregation) metricdata.Aggregation {
+	switch d := data.(type) {
+	case metricdata.Gauge[int64]:
+		e.dataPoints(d.DataPoints)
+	case metricdata.Gauge[float64]:
+		e.dataPoints(d.DataPoints)
+	case metricdata.Sum[int64]:
+		e.dataPoints(d.DataPoints)
+	case metricdata.Sum[float64]:
+		e.dataPoints(d.DataPoints)
+	case metricdata.Histogram[int64]:
+		e.histogramDataPoints(d.DataPoints)
+	case metricdata.Histogram[float64]:
+		e.histogramDataPoints(d.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		e.exponentialHistogramDataPoints(d.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		e.exponentialHistogramDataPoints(d.DataPoints)
+	case metricdata.Summary:
+		for i := range d.DataPoints {
+			d.DataPoints[i].Attributes = e.merge(d.DataPoints[i].Attributes)
+		}
+	}
+
+	return data
+}
+
+func (e *metricAttributes) dataPoints(points any) {
+	switch dps := points.(type) {
+	case []metricdata.DataPoint[int64]:
+		for i := range dps {
+			dps[i].Attributes = e.merge(dps[i].Attributes)
+		}
+	case []metricdata.DataPoint[float64]:
+		for i := range dps {
+			dps[i].Attributes = e.merge(dps[i].Attributes)
+		}
+	}
+}
+
+func (e *metricAttributes) histogramDataPoints(points any) {
+	switch dps := points.(type) {
+	case []metricdata.HistogramDataPoint[int64]:
+		for i := range dps {
+			dps[i].Attributes = e.merge(dps[i].Attributes)
+		}
+	case []metricdata.HistogramDataPoint[float64]:
+		for i := range dps {
+			dps[i].Attributes = e.merge(dps[i].Attributes)
+		}
+	}
+}
+
+func (e *metricAttributes) exponentialHistogramDataPoints(points any) {
+	switch dps := points.(type) {
+	case []metricdata.ExponentialHistogramDataPoint[int64]:
+		for i := range dps {
+			dps[i].Attributes = e.merge(dps[i].Attributes)
+		}
+	case []metricdata.ExponentialHistogramDataPoint[float64]:
+		for i := range dps {
+			dps[i].Attributes = e.merge(dps[i].Attributes)
+		}
+	}
+}
+
+// merge returns set with the static attributes added, the attributes of set taking precedence.
+func (e *metricAttributes) merge(set attribute.Set) attribute.Set {
+	// attribute.NewSet keeps the last value of duplicated keys.
+	kvs := make([]attribute.KeyValue, 0, len(e.attrs)+set.Len())
+	kvs = append(kvs, e.attrs...)
+	kvs = append(kvs, set.ToSlice()...)
+
+	return attribute.NewSet(kvs...)
+}
+
+type resourceMetricAttributes struct {
+	sdkmetric.Exporter
+	keys []attribute.Key
+}
+
+// NewResourceMetricAttributes wraps a metric Exporter so that the given resource attributes are
+// copied onto every data point it exports, for backends that don't query on resource attributes
+// well. Keys missing from the resource are skipped, and measurement attributes with the same keys
+// take precedence. Every promoted attribute is a metric dimension: promoting a high-cardinality
+// resource attribute, such as a pod name, multiplies the number of series of every instrument.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports
+//   - keys: The keys of the resource attributes copied onto the data points
+//
+// Returns:
+//   - sdkmetric.Exporter: The exporter
+func NewResourceMetricAttributes(exporter sdkmetric.Exporter, keys ...attribute.Key) sdkmetric.Exporter {
+	if len(keys) == 0 {
+		return exporter
+	}
+
+	return &resourceMetricAttributes{Exporter: exporter, keys: keys}
+}
+
+func (e *resourceMetricAttributes) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	attrs := make([]attribute.KeyValue, 0, len(e.keys))
+	for _, key := range e.keys {
+		if value, ok := rm.Resource.Set().Value(key); ok {
+			attrs = append(attrs, attribute.KeyValue{Key: key, Value: value})
+		}
+	}
+
+	return NewMetricAttributes(e.Exporter, attrs...).Export(ctx, rm)
+}
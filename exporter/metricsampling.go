@@ -0,0 +1,175 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// MetricSamplingRule keeps the Ratio fraction of the data points of the instruments whose name
+// matches Instrument, a path.Match pattern such as "http.server.*".
+type MetricSamplingRule struct {
+	Instrument string
+	Ratio      float64
+}
+
+// ParseMetricSamplingRules parses metric sampling rules written as "INSTRUMENT=RATIO",
+// e.g. "db.client.operation.duration=0.1".
+//
+// Parameters:
+//   - rules: The rules to be parsed
+//
+// Returns:
+//   - []MetricSamplingRule: The parsed rules, in the given order
+//   - error: An error if a rule is malformed, its pattern is invalid or its ratio is not within [0, 1]
+func ParseMetricSamplingRules(rules []string) ([]MetricSamplingRule, error) {
+	parsed := make([]MetricSamplingRule, 0, len(rules))
+	for _, rule := range rules {
+		instrument, ratio, ok := strings.Cut(rule, "=")
+		instrument = strings.TrimSpace(instrument)
+		if !ok || instrument == "" {
+			return nil, fmt.Errorf("invalid metric sampling rule %q: expected INSTRUMENT=RATIO", rule)
+		}
+
+		if _, err := path.Match(instrument, ""); err != nil {
+			return nil, fmt.Errorf("invalid metric sampling rule %q: %w", rule, err)
+		}
+
+		r, err := strconv.ParseFloat(strings.TrimSpace(ratio), 64)
+		if err != nil || r < 0 || r > 1 {
+			return nil, fmt.Errorf("invalid metric sampling rule %q: the ratio must be within [0, 1]", rule)
+		}
+
+		parsed = append(parsed, MetricSamplingRule{Instrument: instrument, Ratio: r})
+	}
+
+	return parsed, nil
+}
+
+type metricSampler struct {
+	sdkmetric.Exporter
+	rules []MetricSamplingRule
+}
+
+// NewMetricSampler wraps a metric Exporter so that only a fraction of the data points of the
+// instruments matching the rules are exported, the first matching rule applying. It is meant for
+// ultra-high-cardinality instruments for which dropping attributes isn't enough.
+//
+// Sampling is consistent per attribute set: a series is either always or never exported, so the
+// exported series keep exact values, but aggregates across series (sums, counts, percentiles) only
+// cover the sampled ones and underestimate totals by the ratio.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports
+//   - rules: The sampling rules
+//
+// Returns:
+//   - sdkmetric.Exporter: The sampling exporter
+func NewMetricSampler(exporter sdkmetric.Exporter, rules []MetricSamplingRule) sdkmetric.Exporter {
+	if len(rules) == 0 {
+		return exporter
+	}
+
+	return &metricSampler{Exporter: exporter, rules: rules}
+}
+
+func (e *metricSampler) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	for i := range rm.ScopeMetrics {
+		for j := range rm.ScopeMetrics[i].Metrics {
+			m := &rm.ScopeMetrics[i].Metrics[j]
+			if ratio, ok := e.ratio(m.Name); ok {
+				m.Data = sampled(m.Data, ratio)
+			}
+		}
+	}
+
+	return e.Exporter.Export(ctx, rm)
+}
+
+// ratio returns the ratio of the first rule matching the instrument name.
+func (e *metricSampler) ratio(name string) (float64, bool) {
+	for _, rule := range e.rules {
+		if ok, _ := path.Match(rule.Instrument, name); ok {
+			return rule.Ratio, true
+		}
+	}
+
+	return 0, false
+}
+
+func sampled(data metricdata.Aggregation, ratio float64) metricdata.Aggregation {
+	switch d := data.(type) {
+	case metricdata.Gauge[int64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, dataPointAttributes[int64])
+		return d
+	case metricdata.Gauge[float64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, dataPointAttributes[float64])
+		return d
+	case metricdata.Sum[int64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, dataPointAttributes[int64])
+		return d
+	case metricdata.Sum[float64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, dataPointAttributes[float64])
+		return d
+	case metricdata.Histogram[int64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, func(dp metricdata.HistogramDataPoint[int64]) attribute.Set { return dp.Attributes })
+		return d
+	case metricdata.Histogram[float64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, func(dp metricdata.HistogramDataPoint[float64]) attribute.Set { return dp.Attributes })
+		return d
+	case metricdata.ExponentialHistogram[int64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, func(dp metricdata.ExponentialHistogramDataPoint[int64]) attribute.Set { return dp.Attributes })
+		return d
+	case metricdata.ExponentialHistogram[float64]:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, func(dp metricdata.ExponentialHistogramDataPoint[float64]) attribute.Set { return dp.Attributes })
+		return d
+	case metricdata.Summary:
+		d.DataPoints = samplePoints(d.DataPoints, ratio, func(dp metricdata.SummaryDataPoint) attribute.Set { return dp.Attributes })
+		return d
+	}
+
+	return data
+}
+
+func dataPointAttributes[N int64 | float64](dp metricdata.DataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+// samplePoints returns the data points whose attribute set hashes below the ratio.
+func samplePoints[DP any](points []DP, ratio float64, attrs func(DP) attribute.Set) []DP {
+	kept := make([]DP, 0, len(points))
+	for _, dp := range points {
+		if sampledSet(attrs(dp), ratio) {
+			kept = append(kept, dp)
+		}
+	}
+
+	return kept
+}
+
+func sampledSet(set attribute.Set, ratio float64) bool {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(set.Encoded(attribute.DefaultEncoder())))
+
+	// FNV barely mixes the high bits of similar inputs, the splitmix64 finalizer spreads them.
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return float64(x) < ratio*math.MaxUint64
+}
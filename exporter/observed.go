@@ -0,0 +1,81 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Stats is a snapshot of the export activity of an Observed exporter.
+type Stats struct {
+	// Exports is the number of successful export calls.
+	Exports uint64 `json:"exports"`
+	// Failures is the number of failed export calls.
+	Failures uint64 `json:"failures"`
+	// ExportedSpans is the number of spans successfully exported.
+	ExportedSpans uint64 `json:"exported_spans"`
+	// FailedSpans is the number of spans whose export failed.
+	FailedSpans uint64 `json:"failed_spans"`
+	// LastExport is the time of the last successful export call.
+	LastExport time.Time `json:"last_export"`
+	// LastFailure is the time of the last failed export call.
+	LastFailure time.Time `json:"last_failure"`
+	// LastError is the error of the last failed export call.
+	LastError error `json:"-"`
+}
+
+// Observed is a SpanExporter recording statistics about the export calls of the exporter it wraps.
+// It is safe for concurrent use.
+type Observed struct {
+	sdktrace.SpanExporter
+
+	mu    sync.RWMutex
+	stats Stats
+}
+
+// NewObserved wraps a SpanExporter so that its export activity can be inspected through Stats.
+//
+// Parameters:
+//   - exporter: The exporter to be observed
+//
+// Returns:
+//   - *Observed: The observing exporter
+func NewObserved(exporter sdktrace.SpanExporter) *Observed {
+	return &Observed{SpanExporter: exporter}
+}
+
+// ExportSpans exports the spans through the wrapped exporter and records the outcome.
+func (e *Observed) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	err := e.SpanExporter.ExportSpans(ctx, spans)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err != nil {
+		e.stats.Failures++
+		e.stats.FailedSpans += uint64(len(spans))
+		e.stats.LastFailure = time.Now()
+		e.stats.LastError = err
+		return err
+	}
+
+	e.stats.Exports++
+	e.stats.ExportedSpans += uint64(len(spans))
+	e.stats.LastExport = time.Now()
+
+	return nil
+}
+
+// Stats returns a snapshot of the export statistics.
+func (e *Observed) Stats() Stats {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.stats
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import "testing"
+
+func BenchmarkObservedExportSpans(b *testing.B) {
+	benchmarkExportSpans(b, NewObserved(NewDiscard()))
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// OversizedDrop drops the spans exceeding the size limit.
+	OversizedDrop = "drop"
+	// OversizedTruncate removes the events, links and then attributes of the spans exceeding the
+	// size limit until they fit, dropping them only when they still don't.
+	OversizedTruncate = "truncate"
+)
+
+// spanOverhead approximates the encoded size of the fixed fields of a span: ids, timestamps,
+// kind, status code and flags.
+const spanOverhead = 64
+
+// SizeLimited is a SpanExporter keeping the spans whose estimated encoded size exceeds a limit
+// out of the batches handed to the exporter it wraps, so that a single misbehaving span can't make
+// a whole batch exceed the gRPC message size and fail. It is safe for concurrent use.
+type SizeLimited struct {
+	sdktrace.SpanExporter
+
+	maxSize   int
+	truncate  bool
+	oversized atomic.Uint64
+}
+
+// NewSizeLimited wraps a SpanExporter so that the spans larger than maxSize bytes, as estimated by
+// SpanSize, are dropped or, with the OversizedTruncate action, truncated before export.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports
+//   - maxSize: The maximum estimated size of a span in bytes, a non-positive size disabling the limit
+//   - action: OversizedDrop or OversizedTruncate, empty meaning OversizedDrop
+//
+// Returns:
+//   - *SizeLimited: The size limiting exporter
+//   - error: An unknown action
+func NewSizeLimited(exporter sdktrace.SpanExporter, maxSize int, action string) (*SizeLimited, error) {
+	switch action {
+	case "", OversizedDrop, OversizedTruncate:
+	default:
+		return nil, fmt.Errorf("unknown oversized span action %q, expected %q or %q", action, OversizedDrop, OversizedTruncate)
+	}
+
+	return &SizeLimited{SpanExporter: exporter, maxSize: maxSize, truncate: action == OversizedTruncate}, nil
+}
+
+// ExportSpans exports the spans within the size limit, truncated ones included, through the
+// wrapped exporter.
+func (e *SizeLimited) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.maxSize <= 0 {
+		return e.SpanExporter.ExportSpans(ctx, spans)
+	}
+
+	var kept []sdktrace.ReadOnlySpan
+	for i, s := range spans {
+		if SpanSize(s) <= e.maxSize {
+			if kept != nil {
+				kept = append(kept, s)
+			}
+			continue
+		}
+
+		e.oversized.Add(1)
+		if kept == nil {
+			kept = append(make([]sdktrace.ReadOnlySpan, 0, len(spans)), spans[:i]...)
+		}
+		if e.truncate {
+			if t, ok := e.truncated(s); ok {
+				kept = append(kept, t)
+			}
+		}
+	}
+
+	if kept == nil {
+		kept = spans
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+
+	return e.SpanExporter.ExportSpans(ctx, kept)
+}
+
+// Oversized returns the number of spans that exceeded the size limit, whether they were dropped
+// or truncated.
+func (e *SizeLimited) Oversized() uint64 {
+	return e.oversized.Load()
+}
+
+// truncated removes the events, then the links, then the attributes of s, last ones first,
+// until it fits within the size limit.
+func (e *SizeLimited) truncated(s sdktrace.ReadOnlySpan) (sdktrace.ReadOnlySpan, bool) {
+	t := &truncatedSpan{ReadOnlySpan: s, attrs: s.Attributes(), events: s.Events(), links: s.Links()}
+
+	size := SpanSize(s)
+	for i := len(t.events) - 1; i >= 0 && size > e.maxSize; i-- {
+		size -= eventSize(t.events[i])
+		t.events = t.events[:i]
+		t.droppedEvents++
+	}
+	for i := len(t.links) - 1; i >= 0 && size > e.maxSize; i-- {
+		size -= linkSize(t.links[i])
+		t.links = t.links[:i]
+		t.droppedLinks++
+	}
+	for i := len(t.attrs) - 1; i >= 0 && size > e.maxSize; i-- {
+		size -= attributeSize(t.attrs[i])
+		t.attrs = t.attrs[:i]
+		t.droppedAttrs++
+	}
+
+	return t, size <= e.maxSize
+}
+
+// SpanSize estimates the encoded size of a span in bytes from its name, attributes, events, links
+// and status description. It doesn't match the exact OTLP encoding but grows with it.
+//
+// Parameters:
+//   - s: The span
+//
+// Returns:
+//   - int: The estimated size in bytes
+func SpanSize(s sdktrace.ReadOnlySpan) int {
+	size := spanOverhead + len(s.Name()) + len(s.Status().Description) + len(s.SpanContext().TraceState().String())
+	size += attributesSize(s.Attributes())
+	for _, event := range s.Events() {
+		size += eventSize(event)
+	}
+	for _, link := range s.Links() {
+		size += linkSize(link)
+	}
+
+	return size
+}
+
+func eventSize(event sdktrace.Event) int {
+	return 16 + len(event.Name) + attributesSize(event.Attributes)
+}
+
+func linkSize(link sdktrace.Link) int {
+	return 32 + len(link.SpanContext.TraceState().String()) + attributesSize(link.Attributes)
+}
+
+func attributesSize(attrs []attribute.KeyValue) int {
+	size := 0
+	for _, attr := range attrs {
+		size += attributeSize(attr)
+	}
+
+	return size
+}
+
+func attributeSize(attr attribute.KeyValue) int {
+	size := 4 + len(attr.Key)
+
+	switch attr.Value.Type() {
+	case attribute.STRING:
+		size += len(attr.Value.AsString())
+	case attribute.STRINGSLICE:
+		for _, v := range attr.Value.AsStringSlice() {
+			size += 2 + len(v)
+		}
+	case attribute.BOOLSLICE:
+		size += len(attr.Value.AsBoolSlice())
+	case attribute.INT64SLICE:
+		size += 8 * len(attr.Value.AsInt64Slice())
+	case attribute.FLOAT64SLICE:
+		size += 8 * len(attr.Value.AsFloat64Slice())
+	default:
+		size += 8
+	}
+
+	return size
+}
+
+// truncatedSpan overrides the attributes, events and links of an oversized span, accounting for
+// the removed ones in the dropped counts.
+type truncatedSpan struct {
+	sdktrace.ReadOnlySpan
+	attrs         []attribute.KeyValue
+	events        []sdktrace.Event
+	links         []sdktrace.Link
+	droppedAttrs  int
+	droppedEvents int
+	droppedLinks  int
+}
+
+func (s *truncatedSpan) Attributes() []attribute.KeyValue {
+	return s.attrs
+}
+
+func (s *truncatedSpan) Events() []sdktrace.Event {
+	return s.events
+}
+
+func (s *truncatedSpan) Links() []sdktrace.Link {
+	return s.links
+}
+
+func (s *truncatedSpan) DroppedAttributes() int {
+	return s.ReadOnlySpan.DroppedAttributes() + s.droppedAttrs
+}
+
+func (s *truncatedSpan) DroppedEvents() int {
+	return s.ReadOnlySpan.DroppedEvents() + s.droppedEvents
+}
+
+func (s *truncatedSpan) DroppedLinks() int {
+	return s.ReadOnlySpan.DroppedLinks() + s.droppedLinks
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import "testing"
+
+func BenchmarkSizeLimitedExportSpans(b *testing.B) {
+	for _, action := range []string{OversizedDrop, OversizedTruncate} {
+		b.Run(action, func(b *testing.B) {
+			exp, err := NewSizeLimited(NewDiscard(), 64*1024, action)
+			if err != nil {
+				b.Fatalf("NewSizeLimited() error = %v, want nil", err)
+			}
+
+			benchmarkExportSpans(b, exp)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type retryInfo struct {
+	next  sdktrace.SpanExporter
+	clock clock.Clock
+
+	mu        sync.Mutex
+	notBefore time.Time
+}
+
+// NewRetryInfo creates a SpanExporter honoring the retry delay requested by collectors applying
+// backpressure. When an export fails with a RESOURCE_EXHAUSTED or UNAVAILABLE gRPC status carrying
+// a RetryInfo detail, the next export waits for the requested delay instead of the exporter's own
+// backoff. Exports whose context expires before the delay elapses fail without being attempted.
+//
+// Parameters:
+//   - next: The exporter spans are sent to, typically an OTLP/gRPC exporter
+//   - clk: The clock used to wait for the retry delay
+//
+// Returns:
+//   - sdktrace.SpanExporter: The exporter honoring retry delays
+func NewRetryInfo(next sdktrace.SpanExporter, clk clock.Clock) sdktrace.SpanExporter {
+	return &retryInfo{next: next, clock: clk}
+}
+
+func (e *retryInfo) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := e.wait(ctx); err != nil {
+		return err
+	}
+
+	err := e.next.ExportSpans(ctx, spans)
+	if delay, ok := retryDelay(err); ok {
+		e.mu.Lock()
+		e.notBefore = e.clock.Now().Add(delay)
+		e.mu.Unlock()
+	}
+
+	return err
+}
+
+func (e *retryInfo) Shutdown(ctx context.Context) error {
+	return e.next.Shutdown(ctx)
+}
+
+// wait blocks until the retry delay requested by the collector elapses or ctx is done.
+func (e *retryInfo) wait(ctx context.Context) error {
+	e.mu.Lock()
+	notBefore := e.notBefore
+	e.mu.Unlock()
+
+	delay := notBefore.Sub(e.clock.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(notBefore) {
+		return fmt.Errorf("span export throttled by the collector until %s", notBefore.Format(time.RFC3339Nano))
+	}
+
+	timer := e.clock.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.Chan():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryDelay returns the delay of the RetryInfo detail carried by a throttling gRPC status error.
+func retryDelay(err error) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+
+	st, ok := status.FromError(err)
+	if !ok || (st.Code() != codes.ResourceExhausted && st.Code() != codes.Unavailable) {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
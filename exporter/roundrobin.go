@@ -0,0 +1,53 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package exporter provides OpenTelemetry span exporters that wrap and combine the
+// OTLP exporters used by Goxkit applications.
+package exporter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type roundRobin struct {
+	exporters []sdktrace.SpanExporter
+	next      atomic.Uint64
+}
+
+// NewRoundRobin creates a SpanExporter that distributes each export call to the next exporter
+// of the given list in round-robin order. It is used to spread exports across a pool of gRPC
+// connections when a single connection becomes a bottleneck.
+//
+// Parameters:
+//   - exporters: The exporters to distribute export calls to, at least one must be given
+//
+// Returns:
+//   - sdktrace.SpanExporter: The round-robin exporter
+func NewRoundRobin(exporters ...sdktrace.SpanExporter) sdktrace.SpanExporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+
+	return &roundRobin{exporters: exporters}
+}
+
+func (e *roundRobin) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	i := (e.next.Add(1) - 1) % uint64(len(e.exporters))
+	return e.exporters[i].ExportSpans(ctx, spans)
+}
+
+func (e *roundRobin) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, exporter := range e.exporters {
+		if err := exporter.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
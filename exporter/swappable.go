@@ -0,0 +1,242 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// generation is an exporter together with the calls in flight through it.
+type generation[E any] struct {
+	exporter E
+	inflight sync.WaitGroup
+}
+
+// swapper holds the current generation of an exporter, replaced by swap without waiting for
+// the calls in flight through the previous one.
+type swapper[E any] struct {
+	mu      sync.RWMutex
+	current *generation[E]
+}
+
+func newSwapper[E any](exporter E) swapper[E] {
+	return swapper[E]{current: &generation[E]{exporter: exporter}}
+}
+
+// acquire returns the current generation, to be released with inflight.Done once the call returns.
+func (s *swapper[E]) acquire() *generation[E] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	g := s.current
+	g.inflight.Add(1)
+
+	return g
+}
+
+// swap makes next the current exporter and returns the previous one once the calls in flight
+// through it have returned, or ctx is done.
+func (s *swapper[E]) swap(ctx context.Context, next E) (E, error) {
+	s.mu.Lock()
+	previous := s.current
+	s.current = &generation[E]{exporter: next}
+	s.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		previous.inflight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return previous.exporter, nil
+	case <-ctx.Done():
+		return previous.exporter, ctx.Err()
+	}
+}
+
+// Swappable is a SpanExporter whose underlying exporter can be replaced at runtime, e.g. by one
+// exporting over a new gRPC connection, without losing the spans being exported. It is safe for
+// concurrent use.
+type Swappable struct {
+	swapper[sdktrace.SpanExporter]
+}
+
+// NewSwappable wraps a SpanExporter so that it can be replaced with Swap.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports until the first swap
+//
+// Returns:
+//   - *Swappable: The swappable exporter
+func NewSwappable(exporter sdktrace.SpanExporter) *Swappable {
+	return &Swappable{swapper: newSwapper(exporter)}
+}
+
+// ExportSpans exports the spans through the current exporter.
+func (e *Swappable) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.ExportSpans(ctx, spans)
+}
+
+// Shutdown shuts the current exporter down.
+func (e *Swappable) Shutdown(ctx context.Context) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Shutdown(ctx)
+}
+
+// Swap makes next the exporter of the following export calls right away, then waits for the
+// export calls in flight through the previous exporter to return and shuts it down.
+//
+// Parameters:
+//   - ctx: Context bounding the wait and the shutdown of the previous exporter
+//   - next: The exporter replacing the current one
+//
+// Returns:
+//   - error: An error if the in-flight export calls didn't return in time or the shutdown failed
+func (e *Swappable) Swap(ctx context.Context, next sdktrace.SpanExporter) error {
+	previous, err := e.swap(ctx, next)
+
+	return errors.Join(err, previous.Shutdown(ctx))
+}
+
+// SwappableMetric is a metric Exporter whose underlying exporter can be replaced at runtime as a
+// Swappable span exporter. The replacing exporters must use the same temporality and aggregation.
+// It is safe for concurrent use.
+type SwappableMetric struct {
+	swapper[sdkmetric.Exporter]
+}
+
+// NewSwappableMetric wraps a metric Exporter so that it can be replaced with Swap.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports until the first swap
+//
+// Returns:
+//   - *SwappableMetric: The swappable exporter
+func NewSwappableMetric(exporter sdkmetric.Exporter) *SwappableMetric {
+	return &SwappableMetric{swapper: newSwapper(exporter)}
+}
+
+// Temporality returns the temporality of the current exporter.
+func (e *SwappableMetric) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Temporality(kind)
+}
+
+// Aggregation returns the aggregation of the current exporter.
+func (e *SwappableMetric) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Aggregation(kind)
+}
+
+// Export exports the metrics through the current exporter.
+func (e *SwappableMetric) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Export(ctx, rm)
+}
+
+// ForceFlush flushes the current exporter.
+func (e *SwappableMetric) ForceFlush(ctx context.Context) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.ForceFlush(ctx)
+}
+
+// Shutdown shuts the current exporter down.
+func (e *SwappableMetric) Shutdown(ctx context.Context) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Shutdown(ctx)
+}
+
+// Swap replaces the current exporter as Swappable.Swap.
+//
+// Parameters:
+//   - ctx: Context bounding the wait and the shutdown of the previous exporter
+//   - next: The exporter replacing the current one
+//
+// Returns:
+//   - error: An error if the in-flight export calls didn't return in time or the shutdown failed
+func (e *SwappableMetric) Swap(ctx context.Context, next sdkmetric.Exporter) error {
+	previous, err := e.swap(ctx, next)
+
+	return errors.Join(err, previous.Shutdown(ctx))
+}
+
+// SwappableLog is a log Exporter whose underlying exporter can be replaced at runtime as a
+// Swappable span exporter. It is safe for concurrent use.
+type SwappableLog struct {
+	swapper[sdklog.Exporter]
+}
+
+// NewSwappableLog wraps a log Exporter so that it can be replaced with Swap.
+//
+// Parameters:
+//   - exporter: The exporter performing the exports until the first swap
+//
+// Returns:
+//   - *SwappableLog: The swappable exporter
+func NewSwappableLog(exporter sdklog.Exporter) *SwappableLog {
+	return &SwappableLog{swapper: newSwapper(exporter)}
+}
+
+// Export exports the log records through the current exporter.
+func (e *SwappableLog) Export(ctx context.Context, records []sdklog.Record) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Export(ctx, records)
+}
+
+// ForceFlush flushes the current exporter.
+func (e *SwappableLog) ForceFlush(ctx context.Context) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.ForceFlush(ctx)
+}
+
+// Shutdown shuts the current exporter down.
+func (e *SwappableLog) Shutdown(ctx context.Context) error {
+	g := e.acquire()
+	defer g.inflight.Done()
+
+	return g.exporter.Shutdown(ctx)
+}
+
+// Swap replaces the current exporter as Swappable.Swap.
+//
+// Parameters:
+//   - ctx: Context bounding the wait and the shutdown of the previous exporter
+//   - next: The exporter replacing the current one
+//
+// Returns:
+//   - error: An error if the in-flight export calls didn't return in time or the shutdown failed
+func (e *SwappableLog) Swap(ctx context.Context, next sdklog.Exporter) error {
+	previous, err := e.swap(ctx, next)
+
+	return errors.Join(err, previous.Shutdown(ctx))
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/goxkit/configs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// File formats selectable with OTLPConfigs.ExporterFileFormat.
+const (
+	// FileFormatJSON writes each exported batch as an OTLP/JSON ExportTraceServiceRequest on its
+	// own line, readable with grep and jq and replayable with the collector otlpjsonfile receiver.
+	FileFormatJSON = "json"
+	// FileFormatProtobuf writes each exported batch as an OTLP/protobuf ExportTraceServiceRequest
+	// prefixed by its size as a 4-byte big-endian integer, for a lossless replay into a collector.
+	FileFormatProtobuf = "protobuf"
+)
+
+// NewFileSpanExporter creates a span exporter appending the exported spans to the
+// OTLPConfigs.ExporterFilePath file, created when missing, in the OTLPConfigs.ExporterFileFormat
+// format, FileFormatJSON by default. Setup uses it when OTLPConfigs.Exporter is "file", e.g. to
+// debug instrumentation locally or to record traces for a later replay.
+//
+// Parameters:
+//   - ctx: Context used during exporter setup
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - sdktrace.SpanExporter: The span exporter, closing the file on shutdown
+//   - error: An error if the path is empty, the format is unknown or the file can't be opened
+func NewFileSpanExporter(ctx context.Context, cfgs *configs.Configs) (sdktrace.SpanExporter, error) {
+	format, err := fileFormat(cfgs.OTLPConfigs.ExporterFileFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfgs.OTLPConfigs.ExporterFilePath == "" {
+		return nil, fmt.Errorf("invalid otel exporter file: the path is empty")
+	}
+
+	file, err := os.OpenFile(cfgs.OTLPConfigs.ExporterFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the otel exporter file: %w", err)
+	}
+
+	fileExporter, err := otlptrace.New(ctx, &fileClient{w: file, format: format})
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to create file trace exporter: %w", err)
+	}
+
+	return fileExporter, nil
+}
+
+// fileFormat validates OTLPConfigs.ExporterFileFormat, defaulting to FileFormatJSON.
+func fileFormat(format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", FileFormatJSON:
+		return FileFormatJSON, nil
+	case FileFormatProtobuf:
+		return FileFormatProtobuf, nil
+	default:
+		return "", fmt.Errorf("invalid otel exporter file format %q: expected json or protobuf", format)
+	}
+}
+
+// fileClient is an otlptrace.Client writing the export requests to w instead of sending them.
+type fileClient struct {
+	mu     sync.Mutex
+	w      io.WriteCloser
+	format string
+}
+
+func (c *fileClient) Start(context.Context) error {
+	return nil
+}
+
+func (c *fileClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.w.Close()
+}
+
+func (c *fileClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	request := &coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans}
+
+	var record []byte
+	switch c.format {
+	case FileFormatProtobuf:
+		data, err := proto.Marshal(request)
+		if err != nil {
+			return fmt.Errorf("failed to encode the spans: %w", err)
+		}
+		record = binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(data)), uint32(len(data)))
+		record = append(record, data...)
+	default:
+		data, err := marshalOTLPJSON(request)
+		if err != nil {
+			return fmt.Errorf("failed to encode the spans: %w", err)
+		}
+		record = append(data, '\n')
+	}
+
+	// A single write per batch keeps records whole when several exporters append to the file.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err := c.w.Write(record)
+	return err
+}
+
+// otlpJSONIDs are the fields holding trace and span ids, which OTLP/JSON encodes in hex rather
+// than in the base64 of the protobuf JSON mapping.
+var otlpJSONIDs = map[string]bool{"traceId": true, "spanId": true, "parentSpanId": true}
+
+// marshalOTLPJSON encodes an export request in OTLP/JSON, the protobuf JSON mapping with hex ids
+// and enums as numbers, which the collector otlpjsonfile receiver can replay.
+func marshalOTLPJSON(request proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	hexIDs(decoded)
+
+	return json.Marshal(decoded)
+}
+
+// hexIDs rewrites in place the base64 ids of a decoded OTLP JSON document in hex.
+func hexIDs(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if encoded, ok := value.(string); ok && otlpJSONIDs[key] {
+				if id, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+					v[key] = hex.EncodeToString(id)
+				}
+				continue
+			}
+			hexIDs(value)
+		}
+	case []any:
+		for _, value := range v {
+			hexIDs(value)
+		}
+	}
+}
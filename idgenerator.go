@@ -0,0 +1,39 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var idGenerator = struct {
+	sync.RWMutex
+	generator sdktrace.IDGenerator
+}{}
+
+// SetIDGenerator sets the generator of the trace and span ids of the tracer providers created by
+// Setup and NewTracerProvider afterwards, e.g. a deterministic generator in tests or one embedding
+// a timestamp in trace ids for backends expecting it. It should be called before Setup.
+//
+// Ratio sampling decides on the last 8 bytes of the trace id, which must stay random for the
+// sampled fraction to match the ratio.
+//
+// Parameters:
+//   - generator: The id generator, nil restoring the default random generator
+func SetIDGenerator(generator sdktrace.IDGenerator) {
+	idGenerator.Lock()
+	defer idGenerator.Unlock()
+
+	idGenerator.generator = generator
+}
+
+func currentIDGenerator() sdktrace.IDGenerator {
+	idGenerator.RLock()
+	defer idGenerator.RUnlock()
+
+	return idGenerator.generator
+}
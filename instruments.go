@@ -0,0 +1,71 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// instruments caches the instruments created through the Provider helpers, keyed by name.
+type instruments struct {
+	counters   sync.Map
+	histograms sync.Map
+}
+
+// Counter returns the int64 counter with the given name from the package meter of the provider,
+// creating it on the first call only. Later calls return the cached instrument without allocating,
+// which suits hot paths and avoids duplicate instrument registrations; their options are ignored.
+//
+// Parameters:
+//   - name: The instrument name
+//   - opts: Options applied when the instrument is created
+//
+// Returns:
+//   - metric.Int64Counter: The counter
+//   - error: Any error encountered while creating the instrument
+func (p *Provider) Counter(name string, opts ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	if counter, ok := p.instruments.counters.Load(name); ok {
+		return counter.(metric.Int64Counter), nil
+	}
+
+	counter, err := p.meter().Int64Counter(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, _ := p.instruments.counters.LoadOrStore(name, counter)
+	return cached.(metric.Int64Counter), nil
+}
+
+// Histogram returns the float64 histogram with the given name from the package meter of the
+// provider, creating it on the first call only, as Counter does.
+//
+// Parameters:
+//   - name: The instrument name
+//   - opts: Options applied when the instrument is created
+//
+// Returns:
+//   - metric.Float64Histogram: The histogram
+//   - error: Any error encountered while creating the instrument
+func (p *Provider) Histogram(name string, opts ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	if histogram, ok := p.instruments.histograms.Load(name); ok {
+		return histogram.(metric.Float64Histogram), nil
+	}
+
+	histogram, err := p.meter().Float64Histogram(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, _ := p.instruments.histograms.LoadOrStore(name, histogram)
+	return cached.(metric.Float64Histogram), nil
+}
+
+// meter returns the package meter of the provider.
+func (p *Provider) meter() metric.Meter {
+	return p.MeterProvider.Meter(InstrumentationName, metric.WithSchemaURL(SchemaURL()))
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goxkit/configs"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Span exporters selectable with OTLPConfigs.Exporter.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterJaeger = "jaeger"
+	ExporterFile   = "file"
+)
+
+// NewJaegerSpanExporter creates a span exporter sending spans in the Jaeger Thrift format to the
+// Jaeger collector HTTP endpoint OTLPConfigs.TracesEndpoint, or OTLPConfigs.Endpoint when unset
+// (e.g. "http://jaeger-collector:14268/api/traces"). Setup uses it when OTLPConfigs.Exporter is
+// "jaeger", sharing the resource and sampler of the OTLP pipeline.
+//
+// Deprecated: the Jaeger exporter is no longer maintained upstream and Jaeger accepts OTLP natively;
+// it is provided to unblock teams migrating legacy backends and will be removed afterwards.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - sdktrace.SpanExporter: The span exporter
+//   - error: Any error encountered during exporter setup
+func NewJaegerSpanExporter(cfgs *configs.Configs) (sdktrace.SpanExporter, error) {
+	endpoint := signalEndpoint(cfgs, cfgs.OTLPConfigs.TracesEndpoint)
+	if !strings.Contains(endpoint, "://") {
+		return nil, fmt.Errorf("invalid jaeger collector endpoint %q: expected a URL such as http://host:14268/api/traces", endpoint)
+	}
+
+	jaegerExporter, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(endpoint)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jaeger trace exporter: %w", err)
+	}
+
+	return jaegerExporter, nil
+}
+
+// spanExporterKind validates OTLPConfigs.Exporter, defaulting to ExporterOTLP.
+func spanExporterKind(cfgs *configs.Configs) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(cfgs.OTLPConfigs.Exporter)) {
+	case "", ExporterOTLP:
+		return ExporterOTLP, nil
+	case ExporterJaeger:
+		return ExporterJaeger, nil
+	case ExporterFile:
+		return ExporterFile, nil
+	default:
+		return "", fmt.Errorf("invalid otel span exporter %q: expected otlp, jaeger or file", cfgs.OTLPConfigs.Exporter)
+	}
+}
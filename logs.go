@@ -0,0 +1,103 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/logs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"google.golang.org/grpc"
+)
+
+// NewLoggerProvider creates a LoggerProvider exporting log records over the given OTLP gRPC
+// connection through a batch log processor.
+//
+// When OTLPConfigs.LogSampledTracesOnly is enabled, records emitted within a trace that was not
+// sampled are dropped (see logs.NewSampledTracesOnly), while records without trace context are
+// still exported.
+//
+// Record attributes matching the OTLPConfigs.RedactAttributes rules are masked or dropped
+// before export (see logs.NewRedactor).
+//
+// When OTLPConfigs.TenantAttribute is set, records emitted with a context carrying a tenant
+// (see WithTenant) carry it under that attribute key.
+//
+// The OTLPConfigs.LogAttributes key=value pairs are added to every record.
+//
+// Unless OTLPConfigs.DisableLogTraceCorrelation is set, records are correlated with the active
+// trace: they carry its trace context and the trace_id and span_id attributes (see
+// logs.NewTraceContext).
+//
+// Parameters:
+//   - ctx: Context used to create the exporter
+//   - cfgs: Application configurations containing OTLP settings
+//   - conn: The gRPC connection to the OTLP collector
+//   - res: The resource describing the application, as returned by NewResource
+//
+// Returns:
+//   - *sdklog.LoggerProvider: The configured logger provider
+//   - error: Any error encountered during exporter setup or an invalid redaction rule
+func NewLoggerProvider(ctx context.Context, cfgs *configs.Configs, conn *grpc.ClientConn, res *resource.Resource) (*sdklog.LoggerProvider, error) {
+	logExporter, err := newLogExporter(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLoggerProvider(cfgs, logExporter, res)
+}
+
+// newLogExporter creates the OTLP log exporter sending log records over conn.
+func newLogExporter(ctx context.Context, conn *grpc.ClientConn) (sdklog.Exporter, error) {
+	logExporter, err := otlploggrpc.New(ctx, otlploggrpc.WithGRPCConn(conn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp log exporter: %w", err)
+	}
+
+	return logExporter, nil
+}
+
+// newLoggerProvider creates the LoggerProvider described by NewLoggerProvider, exporting through
+// logExporter.
+func newLoggerProvider(cfgs *configs.Configs, logExporter sdklog.Exporter, res *resource.Resource) (*sdklog.LoggerProvider, error) {
+	redactor, err := newRedactor(cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var lp sdklog.Processor = logs.NewRedactor(sdklog.NewBatchProcessor(logExporter), redactor)
+	if cfgs.OTLPConfigs.LogSampledTracesOnly {
+		lp = logs.NewSampledTracesOnly(lp)
+	}
+	if cfgs.OTLPConfigs.TenantAttribute != "" {
+		lp = logs.NewContextAttribute(lp, cfgs.OTLPConfigs.TenantAttribute, TenantFromContext)
+	}
+	lp = logs.NewStaticAttributes(lp, logAttributes(cfgs)...)
+	if !cfgs.OTLPConfigs.DisableLogTraceCorrelation {
+		lp = logs.NewTraceContext(lp)
+	}
+
+	return sdklog.NewLoggerProvider(
+		sdklog.WithResource(res),
+		sdklog.WithProcessor(lp),
+	), nil
+}
+
+// logAttributes returns OTLPConfigs.LogAttributes as log attributes.
+func logAttributes(cfgs *configs.Configs) []log.KeyValue {
+	attrs := signalAttributes(cfgs.OTLPConfigs.LogAttributes)
+
+	kvs := make([]log.KeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		kvs = append(kvs, log.String(string(attr.Key), attr.Value.AsString()))
+	}
+
+	return kvs
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type contextAttribute struct {
+	next  sdklog.Processor
+	key   string
+	value func(context.Context) string
+}
+
+// NewContextAttribute creates a log processor setting the key attribute on each record to the
+// value read from the context the record is emitted with, e.g. the tenant of a request, before
+// passing it on to the next processor. Records emitted without a value are left untouched.
+//
+// Parameters:
+//   - next: The processor records are passed on to
+//   - key: The attribute key
+//   - value: Returns the attribute value carried by a context, or an empty string
+//
+// Returns:
+//   - sdklog.Processor: The context attribute processor
+func NewContextAttribute(next sdklog.Processor, key string, value func(context.Context) string) sdklog.Processor {
+	return &contextAttribute{next: next, key: key, value: value}
+}
+
+func (p *contextAttribute) OnEmit(ctx context.Context, record *sdklog.Record) error {
+	if v := p.value(ctx); v != "" {
+		record.AddAttributes(log.String(p.key, v))
+	}
+
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *contextAttribute) Enabled(ctx context.Context, param sdklog.EnabledParameters) bool {
+	if filter, ok := p.next.(sdklog.FilterProcessor); ok {
+		return filter.Enabled(ctx, param)
+	}
+
+	return true
+}
+
+func (p *contextAttribute) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *contextAttribute) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
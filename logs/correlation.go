@@ -0,0 +1,64 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Keys of the trace correlation attributes added by TraceContextHandler and NewTraceContext.
+const (
+	TraceIDKey = "trace_id"
+	SpanIDKey  = "span_id"
+)
+
+// TraceContextHandler is a slog.Handler adding the trace_id and span_id attributes of the span
+// context carried by the context passed to the slog calls, so that console logs can be correlated
+// with traces. The OpenTelemetry Handler doesn't need it, since log records carry the trace
+// context natively.
+type TraceContextHandler struct {
+	next slog.Handler
+}
+
+// NewTraceContextHandler creates a TraceContextHandler handing the records to next.
+//
+// Parameters:
+//   - next: The handler records are handed to
+//
+// Returns:
+//   - *TraceContextHandler: The correlating handler
+func NewTraceContextHandler(next slog.Handler) *TraceContextHandler {
+	return &TraceContextHandler{next: next}
+}
+
+// Enabled reports whether the next handler handles records of the given level.
+func (h *TraceContextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the trace correlation attributes to the record and hands it to the next handler.
+func (h *TraceContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(
+			slog.String(TraceIDKey, sc.TraceID().String()),
+			slog.String(SpanIDKey, sc.SpanID().String()),
+		)
+	}
+
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a TraceContextHandler whose next handler adds the given attributes to every record.
+func (h *TraceContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &TraceContextHandler{next: h.next.WithAttrs(attrs)}
+}
+
+// WithGroup returns a TraceContextHandler whose next handler groups the following attributes under name.
+func (h *TraceContextHandler) WithGroup(name string) slog.Handler {
+	return &TraceContextHandler{next: h.next.WithGroup(name)}
+}
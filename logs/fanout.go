@@ -0,0 +1,75 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// Fanout is a slog.Handler handing every record to several handlers, e.g. a console handler
+// and the OpenTelemetry Handler.
+type Fanout struct {
+	handlers []slog.Handler
+}
+
+// NewFanout creates a Fanout handing records to the given handlers, each of them only receiving
+// the records of the levels it enables.
+//
+// Parameters:
+//   - handlers: The handlers records are handed to
+//
+// Returns:
+//   - *Fanout: The fan-out handler
+func NewFanout(handlers ...slog.Handler) *Fanout {
+	return &Fanout{handlers: handlers}
+}
+
+// Enabled reports whether any of the handlers handles records of the given level.
+func (f *Fanout) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range f.handlers {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle hands the record to the handlers enabling its level, returning their errors joined.
+func (f *Fanout) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, h := range f.handlers {
+		if !h.Enabled(ctx, r.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, r.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithAttrs returns a Fanout whose handlers add the given attributes to every record.
+func (f *Fanout) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make([]slog.Handler, 0, len(f.handlers))
+	for _, h := range f.handlers {
+		handlers = append(handlers, h.WithAttrs(attrs))
+	}
+	return &Fanout{handlers: handlers}
+}
+
+// WithGroup returns a Fanout whose handlers group the following attributes under name.
+func (f *Fanout) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return f
+	}
+
+	handlers := make([]slog.Handler, 0, len(f.handlers))
+	for _, h := range f.handlers {
+		handlers = append(handlers, h.WithGroup(name))
+	}
+	return &Fanout{handlers: handlers}
+}
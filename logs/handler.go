@@ -0,0 +1,167 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package logs provides the log/slog bridge to the OpenTelemetry logs pipeline used by
+// Goxkit applications, with configurable slog level to OpenTelemetry severity mapping.
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/goxkit/configs"
+	"go.opentelemetry.io/otel/log"
+)
+
+// ScopeName is the instrumentation scope name of the logger used by the slog bridge.
+const ScopeName = "github.com/goxkit/otel/logs"
+
+// Handler is a slog.Handler emitting slog records as OpenTelemetry log records.
+// Attributes of groups are flattened with dot-separated keys (e.g. "request.id").
+type Handler struct {
+	logger   log.Logger
+	severity *SeverityMapping
+	attrs    []log.KeyValue
+	prefix   string
+}
+
+// New creates a slog.Handler emitting records through a logger of the given provider, mapping
+// slog levels to OpenTelemetry severities according to OTLPConfigs.LogSeverityMapping
+// (see NewSeverityMapping for the format).
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//   - provider: The LoggerProvider the records are emitted to
+//
+// Returns:
+//   - *Handler: The slog handler
+//   - error: An error if the severity mapping is malformed
+func New(cfgs *configs.Configs, provider log.LoggerProvider) (*Handler, error) {
+	severity, err := NewSeverityMapping(cfgs.OTLPConfigs.LogSeverityMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHandler(provider, severity), nil
+}
+
+// NewHandler creates a slog.Handler emitting records through a logger of the given provider,
+// mapping slog levels to OpenTelemetry severities with the given mapping. The trace context
+// carried by the context passed to the slog calls is attached to every record.
+//
+// Parameters:
+//   - provider: The LoggerProvider the records are emitted to
+//   - severity: The severity mapping, the default mapping is used when nil
+//
+// Returns:
+//   - *Handler: The slog handler
+func NewHandler(provider log.LoggerProvider, severity *SeverityMapping) *Handler {
+	return &Handler{
+		logger:   provider.Logger(ScopeName),
+		severity: severity,
+	}
+}
+
+// Enabled reports whether the logger emits records of the given level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.logger.Enabled(ctx, log.EnabledParameters{Severity: h.severity.Severity(level)})
+}
+
+// Handle converts the slog record into an OpenTelemetry log record and emits it.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	var record log.Record
+	record.SetTimestamp(r.Time)
+	record.SetObservedTimestamp(time.Now())
+	record.SetSeverity(h.severity.Severity(r.Level))
+	record.SetSeverityText(r.Level.String())
+	record.SetBody(log.StringValue(r.Message))
+	record.AddAttributes(h.attrs...)
+
+	r.Attrs(func(a slog.Attr) bool {
+		record.AddAttributes(convertAttr(h.prefix, a)...)
+		return true
+	})
+
+	h.logger.Emit(ctx, record)
+	return nil
+}
+
+// WithAttrs returns a Handler adding the given attributes to every record.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append([]log.KeyValue{}, h.attrs...)
+	for _, a := range attrs {
+		clone.attrs = append(clone.attrs, convertAttr(h.prefix, a)...)
+	}
+
+	return &clone
+}
+
+// WithGroup returns a Handler prefixing the keys of the following attributes with name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	clone := *h
+	clone.prefix = h.prefix + name + "."
+	return &clone
+}
+
+func convertAttr(prefix string, a slog.Attr) []log.KeyValue {
+	v := a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return nil
+	}
+
+	if v.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if a.Key != "" {
+			groupPrefix = prefix + a.Key + "."
+		}
+
+		kvs := []log.KeyValue{}
+		for _, ga := range v.Group() {
+			kvs = append(kvs, convertAttr(groupPrefix, ga)...)
+		}
+		return kvs
+	}
+
+	return []log.KeyValue{{Key: prefix + a.Key, Value: convertValue(v)}}
+}
+
+func convertValue(v slog.Value) log.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return log.StringValue(v.String())
+	case slog.KindInt64:
+		return log.Int64Value(v.Int64())
+	case slog.KindUint64:
+		if v.Uint64() > math.MaxInt64 {
+			return log.StringValue(strconv.FormatUint(v.Uint64(), 10))
+		}
+		return log.Int64Value(int64(v.Uint64()))
+	case slog.KindFloat64:
+		return log.Float64Value(v.Float64())
+	case slog.KindBool:
+		return log.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return log.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindTime:
+		return log.StringValue(v.Time().Format(time.RFC3339Nano))
+	default:
+		switch value := v.Any().(type) {
+		case []byte:
+			return log.BytesValue(value)
+		case error:
+			return log.StringValue(value.Error())
+		default:
+			return log.StringValue(fmt.Sprint(value))
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+
+	"github.com/goxkit/otel/redact"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type redactor struct {
+	next     sdklog.Processor
+	redactor *redact.Redactor
+}
+
+// NewRedactor creates a log processor applying the redaction rules of r to the attributes of the
+// records before passing them on to the next processor. The record body is left untouched.
+//
+// Parameters:
+//   - next: The processor receiving the redacted records
+//   - r: The redactor, a nil redactor leaving records untouched
+//
+// Returns:
+//   - sdklog.Processor: The redacting processor
+func NewRedactor(next sdklog.Processor, r *redact.Redactor) sdklog.Processor {
+	if r == nil {
+		return next
+	}
+
+	return &redactor{next: next, redactor: r}
+}
+
+func (p *redactor) OnEmit(ctx context.Context, record *sdklog.Record) error {
+	attrs := make([]log.KeyValue, 0, record.AttributesLen())
+	changed := false
+
+	record.WalkAttributes(func(kv log.KeyValue) bool {
+		action, ok := p.redactor.Match(kv.Key, kv.Value.String())
+		switch {
+		case !ok:
+			attrs = append(attrs, kv)
+		case action == redact.ActionRedact:
+			attrs = append(attrs, log.String(kv.Key, redact.Mask))
+			changed = true
+		default:
+			changed = true
+		}
+		return true
+	})
+
+	if changed {
+		record.SetAttributes(attrs...)
+	}
+
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *redactor) Enabled(ctx context.Context, param sdklog.EnabledParameters) bool {
+	if filter, ok := p.next.(sdklog.FilterProcessor); ok {
+		return filter.Enabled(ctx, param)
+	}
+
+	return true
+}
+
+func (p *redactor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *redactor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type sampledTracesOnly struct {
+	next sdklog.Processor
+}
+
+// NewSampledTracesOnly creates a log processor dropping the records emitted within a trace that
+// was not sampled, coupling log volume to trace sampling. Records without trace context are
+// passed on to the next processor.
+//
+// The processor also implements sdklog.FilterProcessor, so that loggers report records emitted
+// from an unsampled span context as disabled and callers such as the slog Handler skip building them.
+//
+// Parameters:
+//   - next: The processor records of sampled traces are passed on to
+//
+// Returns:
+//   - sdklog.Processor: The filtering processor
+func NewSampledTracesOnly(next sdklog.Processor) sdklog.Processor {
+	return &sampledTracesOnly{next: next}
+}
+
+func (p *sampledTracesOnly) OnEmit(ctx context.Context, record *sdklog.Record) error {
+	if record.TraceID().IsValid() && !record.TraceFlags().IsSampled() {
+		return nil
+	}
+
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *sampledTracesOnly) Enabled(ctx context.Context, param sdklog.EnabledParameters) bool {
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.IsValid() && !sc.IsSampled() {
+		return false
+	}
+
+	if filter, ok := p.next.(sdklog.FilterProcessor); ok {
+		return filter.Enabled(ctx, param)
+	}
+
+	return true
+}
+
+func (p *sampledTracesOnly) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *sampledTracesOnly) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
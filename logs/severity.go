@@ -0,0 +1,117 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// SeverityMapping maps slog levels to OpenTelemetry severity numbers.
+//
+// The default mapping follows the OpenTelemetry data model, which aligns the slog levels with
+// the first severity number of each range and maps intermediate levels linearly:
+//
+//	| slog level | severity    | number |
+//	|------------|-------------|--------|
+//	| DEBUG      | DEBUG       | 5      |
+//	| DEBUG+2    | DEBUG3      | 7      |
+//	| INFO       | INFO        | 9      |
+//	| INFO+2     | INFO3       | 11     |
+//	| WARN       | WARN        | 13     |
+//	| WARN+2     | WARN3       | 15     |
+//	| ERROR      | ERROR       | 17     |
+//	| ERROR+4    | FATAL       | 21     |
+//
+// Levels below DEBUG-4 and above ERROR+7 are clamped to TRACE and FATAL4 respectively.
+// Overrides replace the severity of specific levels, e.g. to map a custom NOTICE level.
+type SeverityMapping struct {
+	overrides map[slog.Level]log.Severity
+}
+
+// NewSeverityMapping parses a comma-separated list of LEVEL=SEVERITY overrides on top of the
+// default mapping. LEVEL uses the slog text format ("INFO", "WARN+2", "DEBUG-4") and SEVERITY
+// is either an OpenTelemetry severity name ("INFO4", "FATAL") or number ("12").
+//
+// Parameters:
+//   - spec: The overrides, e.g. "INFO+2=INFO4,ERROR+4=FATAL"; empty for the default mapping
+//
+// Returns:
+//   - *SeverityMapping: The severity mapping
+//   - error: An error if an override is malformed
+func NewSeverityMapping(spec string) (*SeverityMapping, error) {
+	m := &SeverityMapping{overrides: map[slog.Level]log.Severity{}}
+	if strings.TrimSpace(spec) == "" {
+		return m, nil
+	}
+
+	for entry := range strings.SplitSeq(spec, ",") {
+		levelText, severityText, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid log severity mapping %q: expected LEVEL=SEVERITY", entry)
+		}
+
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(strings.TrimSpace(levelText))); err != nil {
+			return nil, fmt.Errorf("invalid log severity mapping %q: %w", entry, err)
+		}
+
+		severity, err := parseSeverity(strings.TrimSpace(severityText))
+		if err != nil {
+			return nil, fmt.Errorf("invalid log severity mapping %q: %w", entry, err)
+		}
+
+		m.overrides[level] = severity
+	}
+
+	return m, nil
+}
+
+// Severity returns the OpenTelemetry severity of the given slog level.
+func (m *SeverityMapping) Severity(level slog.Level) log.Severity {
+	if m != nil {
+		if severity, ok := m.overrides[level]; ok {
+			return severity
+		}
+	}
+
+	return DefaultSeverity(level)
+}
+
+// DefaultSeverity returns the OpenTelemetry severity of the given slog level
+// according to the default mapping described in SeverityMapping.
+func DefaultSeverity(level slog.Level) log.Severity {
+	severity := int(level) + int(log.SeverityInfo)
+
+	switch {
+	case severity < int(log.SeverityTrace1):
+		return log.SeverityTrace1
+	case severity > int(log.SeverityFatal4):
+		return log.SeverityFatal4
+	default:
+		return log.Severity(severity)
+	}
+}
+
+func parseSeverity(text string) (log.Severity, error) {
+	if n, err := strconv.Atoi(text); err == nil {
+		if n < int(log.SeverityTrace1) || n > int(log.SeverityFatal4) {
+			return 0, fmt.Errorf("severity number %d out of range [1, 24]", n)
+		}
+		return log.Severity(n), nil
+	}
+
+	for s := log.SeverityTrace1; s <= log.SeverityFatal4; s++ {
+		if strings.EqualFold(s.String(), text) {
+			return s, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown severity %q", text)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type staticAttributes struct {
+	next  sdklog.Processor
+	attrs []log.KeyValue
+}
+
+// NewStaticAttributes creates a log processor adding the given attributes to each record before
+// passing it on to the next processor. Unlike resource attributes, they only apply to logs, not
+// to the other signals.
+//
+// Parameters:
+//   - next: The processor records are passed on to
+//   - attrs: The attributes added to every record
+//
+// Returns:
+//   - sdklog.Processor: The processor
+func NewStaticAttributes(next sdklog.Processor, attrs ...log.KeyValue) sdklog.Processor {
+	if len(attrs) == 0 {
+		return next
+	}
+
+	return &staticAttributes{next: next, attrs: attrs}
+}
+
+func (p *staticAttributes) OnEmit(ctx context.Context, record *sdklog.Record) error {
+	record.AddAttributes(p.attrs...)
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *staticAttributes) Enabled(ctx context.Context, param sdklog.EnabledParameters) bool {
+	if filter, ok := p.next.(sdklog.FilterProcessor); ok {
+		return filter.Enabled(ctx, param)
+	}
+
+	return true
+}
+
+func (p *staticAttributes) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *staticAttributes) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package logs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type traceContext struct {
+	next sdklog.Processor
+}
+
+// NewTraceContext creates a log processor correlating every record with the active trace before
+// passing it on to the next processor. Records missing the trace context get the one of the span
+// carried by the emit context, and records within a trace get the trace_id and span_id attributes
+// (TraceIDKey and SpanIDKey), for backends indexing attributes rather than the OTLP trace fields.
+// It is the logs-side counterpart of exemplars.
+//
+// Parameters:
+//   - next: The processor records are passed on to
+//
+// Returns:
+//   - sdklog.Processor: The correlating processor
+func NewTraceContext(next sdklog.Processor) sdklog.Processor {
+	return &traceContext{next: next}
+}
+
+func (p *traceContext) OnEmit(ctx context.Context, record *sdklog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); !record.TraceID().IsValid() && sc.IsValid() {
+		record.SetTraceID(sc.TraceID())
+		record.SetSpanID(sc.SpanID())
+		record.SetTraceFlags(sc.TraceFlags())
+	}
+
+	if record.TraceID().IsValid() {
+		record.AddAttributes(
+			log.String(TraceIDKey, record.TraceID().String()),
+			log.String(SpanIDKey, record.SpanID().String()),
+		)
+	}
+
+	return p.next.OnEmit(ctx, record)
+}
+
+func (p *traceContext) Enabled(ctx context.Context, param sdklog.EnabledParameters) bool {
+	if filter, ok := p.next.(sdklog.FilterProcessor); ok {
+		return filter.Enabled(ctx, param)
+	}
+
+	return true
+}
+
+func (p *traceContext) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *traceContext) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,418 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/exporter"
+	"github.com/goxkit/otel/otlpgrpc"
+	"github.com/goxkit/otel/processor"
+	"go.opentelemetry.io/contrib/instrumentation/host"
+	"go.opentelemetry.io/contrib/instrumentation/runtime"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// LogRecordIDKey is the attribute key identifying the log record emitted alongside a measurement.
+// When OTLPConfigs.ExemplarLogRecordID is enabled, it is removed from the metric dimensions but
+// kept on the exemplars, linking metrics to logs in addition to traces.
+const LogRecordIDKey = attribute.Key("log.record.id")
+
+// Default periodic reader settings used when OTLPConfigs.MetricExportInterval and
+// OTLPConfigs.MetricExportTimeout are not set, matching the OpenTelemetry SDK defaults.
+const (
+	DefaultMetricExportInterval = time.Minute
+	DefaultMetricExportTimeout  = 30 * time.Second
+)
+
+// DefaultRuntimeMetricsInterval is the minimum interval between runtime statistics reads
+// used when OTLPConfigs.RuntimeMetricsInterval is not set.
+const DefaultRuntimeMetricsInterval = 15 * time.Second
+
+// NewMeterProvider creates a MeterProvider exporting metrics over the given OTLP gRPC connection
+// through a periodic reader. When OTLPConfigs.RuntimeMetrics is enabled, the Go runtime
+// instrumentation (goroutines, GC, heap and scheduler metrics) is registered on the provider,
+// and when OTLPConfigs.HostMetrics is enabled, the host instrumentation (CPU, memory and network)
+// is registered as well.
+//
+// Attributes listed in OTLPConfigs.DropMetricAttributes are removed from every instrument
+// before aggregation, which caps the cardinality introduced by attributes such as user ids.
+// Attributes matching the OTLPConfigs.RedactAttributes rules are removed as well, since views
+// can't rewrite values. The OTLPConfigs.MetricAttributes key=value pairs are added to every data
+// point at export time (see exporter.NewMetricAttributes).
+//
+// The resource attributes listed in OTLPConfigs.ResourceMetricAttributes are copied onto every
+// data point as well (see exporter.NewResourceMetricAttributes); each of them becomes a metric
+// dimension, so high-cardinality resource attributes multiply the number of series.
+//
+// OTLPConfigs.MetricDataPointSampling rules, written as "INSTRUMENT=RATIO", export only a fraction
+// of the series of matching ultra-high-cardinality instruments (see exporter.NewMetricSampler).
+//
+// Exemplars carry the trace and span ids of the sampled span active during the measurement.
+// When OTLPConfigs.ExemplarLogRecordID is enabled, the LogRecordIDKey attribute recorded with
+// a measurement is also kept on its exemplars (as a filtered attribute) without becoming a metric
+// dimension, enabling metric to log correlation in backends supporting it.
+//
+// Metrics are collected and exported every OTLPConfigs.MetricExportInterval
+// (DefaultMetricExportInterval when unset), each export being bounded by
+// OTLPConfigs.MetricExportTimeout (DefaultMetricExportTimeout when unset), so that e.g. development
+// environments get fast feedback while production limits the export load.
+//
+// Host metrics are best effort: if the instrumentation can't be registered on the current
+// platform a warning is logged and the provider is returned without them. They don't include
+// disk I/O or usage metrics, which the contrib host instrumentation doesn't provide.
+//
+// Runtime and host metrics are collected through callbacks owned by the provider, so they stop
+// together with it once MeterProvider.Shutdown is called.
+//
+// Parameters:
+//   - ctx: Context used to create the exporter
+//   - cfgs: Application configurations containing OTLP settings
+//   - conn: The gRPC connection to the OTLP collector
+//   - res: The resource describing the application, as returned by NewResource
+//
+// Returns:
+//   - *sdkmetric.MeterProvider: The configured meter provider
+//   - error: Any error encountered during exporter or instrumentation setup
+func NewMeterProvider(ctx context.Context, cfgs *configs.Configs, conn *grpc.ClientConn, res *resource.Resource) (*sdkmetric.MeterProvider, error) {
+	exporter, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithGRPCConn(conn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp metric exporter: %w", err)
+	}
+
+	return newMeterProvider(ctx, cfgs, exporter, res)
+}
+
+// NewHTTPMetricExporter creates the OTLP/HTTP metric exporter used when OTLPConfigs.MetricsProtocol
+// is "http". It sends metrics to OTLPConfigs.MetricsEndpoint, or OTLPConfigs.Endpoint when unset,
+// written either as host:port, the default "/v1/metrics" path being used and TLS following
+// OTLPConfigs.ExporterTLSEnabled, or as a full URL. The configured headers and gzip compression
+// (OTLPConfigs.MetricsCompression, defaulting to OTLPConfigs.ExporterCompression) apply.
+//
+// Parameters:
+//   - ctx: Context used to create the exporter
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - sdkmetric.Exporter: The metric exporter
+//   - error: Any error encountered during exporter setup
+func NewHTTPMetricExporter(ctx context.Context, cfgs *configs.Configs) (sdkmetric.Exporter, error) {
+	opts := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithHeaders(otlpgrpc.ParseHeaders(cfgs.OTLPConfigs.ExporterHeaders)),
+	}
+
+	endpoint := newHTTPEndpoint(cfgs, signalEndpoint(cfgs, cfgs.OTLPConfigs.MetricsEndpoint))
+	switch {
+	case endpoint.url != "":
+		opts = append(opts, otlpmetrichttp.WithEndpointURL(endpoint.url))
+	case endpoint.insecure:
+		opts = append(opts, otlpmetrichttp.WithEndpoint(endpoint.hostPort), otlpmetrichttp.WithInsecure())
+	default:
+		opts = append(opts, otlpmetrichttp.WithEndpoint(endpoint.hostPort))
+	}
+
+	if signalCompression(cfgs, cfgs.OTLPConfigs.MetricsCompression) == otlpgrpc.CompressionGzip {
+		opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+	}
+
+	exporter, err := otlpmetrichttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp http metric exporter: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// newMeterProvider creates the MeterProvider described by NewMeterProvider, exporting through metricExporter.
+func newMeterProvider(ctx context.Context, cfgs *configs.Configs, metricExporter sdkmetric.Exporter, res *resource.Resource) (*sdkmetric.MeterProvider, error) {
+	interval := cfgs.OTLPConfigs.MetricExportInterval
+	if interval <= 0 {
+		interval = DefaultMetricExportInterval
+	}
+	timeout := cfgs.OTLPConfigs.MetricExportTimeout
+	if timeout <= 0 {
+		timeout = DefaultMetricExportTimeout
+	}
+
+	readerOpts := []sdkmetric.PeriodicReaderOption{sdkmetric.WithInterval(interval), sdkmetric.WithTimeout(timeout)}
+	if cfgs.OTLPConfigs.RuntimeMetrics {
+		readerOpts = append(readerOpts, sdkmetric.WithProducer(runtime.NewProducer()))
+	}
+
+	views, err := metricViews(cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	samplingRules, err := exporter.ParseMetricSamplingRules(cfgs.OTLPConfigs.MetricDataPointSampling)
+	if err != nil {
+		return nil, err
+	}
+
+	metricExporter = exporter.NewMetricAttributes(metricExporter, signalAttributes(cfgs.OTLPConfigs.MetricAttributes)...)
+	metricExporter = exporter.NewResourceMetricAttributes(metricExporter, attributeKeys(cfgs.OTLPConfigs.ResourceMetricAttributes)...)
+	metricExporter = exporter.NewMetricSampler(metricExporter, samplingRules)
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithView(views...),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter, readerOpts...)),
+	)
+
+	if cfgs.OTLPConfigs.RuntimeMetrics {
+		if err := startRuntimeMetrics(cfgs, mp); err != nil {
+			_ = mp.Shutdown(ctx)
+			return nil, err
+		}
+	}
+
+	if cfgs.OTLPConfigs.HostMetrics {
+		if err := host.Start(host.WithMeterProvider(mp)); err != nil {
+			logger(cfgs).Warn("host metrics are not available on this platform", zap.Error(err))
+		}
+	}
+
+	return mp, nil
+}
+
+// metricViews returns the views described by the configurations. Attributes removed from
+// every instrument and metadata overrides are combined in a single wildcard view, since each
+// matching view produces its own stream.
+func metricViews(cfgs *configs.Configs) ([]sdkmetric.View, error) {
+	views := []sdkmetric.View{}
+
+	keys := make([]attribute.Key, 0, len(cfgs.OTLPConfigs.DropMetricAttributes)+1)
+	for _, key := range cfgs.OTLPConfigs.DropMetricAttributes {
+		keys = append(keys, attribute.Key(key))
+	}
+
+	// Attributes filtered out by a view are kept on exemplars, so filtering the log record id
+	// links exemplars to their log line without adding a metric dimension.
+	if cfgs.OTLPConfigs.ExemplarLogRecordID {
+		keys = append(keys, LogRecordIDKey)
+	}
+
+	redactor, err := newRedactor(cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter attribute.Filter
+	switch {
+	case redactor != nil && len(keys) > 0:
+		deny, redacted := attribute.NewDenyKeysFilter(keys...), redactor.Filter()
+		filter = func(kv attribute.KeyValue) bool {
+			return deny(kv) && redacted(kv)
+		}
+	case redactor != nil:
+		filter = redactor.Filter()
+	case len(keys) > 0:
+		filter = attribute.NewDenyKeysFilter(keys...)
+	}
+
+	units, err := parseMetricMetadata(cfgs.OTLPConfigs.MetricUnits, "unit")
+	if err != nil {
+		return nil, err
+	}
+	descriptions, err := parseMetricMetadata(cfgs.OTLPConfigs.MetricDescriptions, "description")
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == nil && len(units) == 0 && len(descriptions) == 0 {
+		return views, nil
+	}
+
+	// sdkmetric.NewView can't override the metadata per instrument within a wildcard view, and
+	// separate views would each produce their own stream.
+	views = append(views, func(i sdkmetric.Instrument) (sdkmetric.Stream, bool) {
+		stream := sdkmetric.Stream{
+			Name:            i.Name,
+			Description:     i.Description,
+			Unit:            i.Unit,
+			AttributeFilter: filter,
+		}
+		if unit, ok := matchMetricMetadata(units, i.Name); ok {
+			stream.Unit = unit
+		}
+		if description, ok := matchMetricMetadata(descriptions, i.Name); ok {
+			stream.Description = description
+		}
+		return stream, true
+	})
+
+	return views, nil
+}
+
+// metricMetadata overrides a unit or description of the instruments whose name matches
+// instrument, a path.Match pattern.
+type metricMetadata struct {
+	instrument string
+	value      string
+}
+
+// parseMetricMetadata parses metadata overrides written as "INSTRUMENT=VALUE", kind naming the
+// overridden metadata in errors.
+func parseMetricMetadata(rules []string, kind string) ([]metricMetadata, error) {
+	parsed := make([]metricMetadata, 0, len(rules))
+	for _, rule := range rules {
+		instrument, value, ok := strings.Cut(rule, "=")
+		instrument, value = strings.TrimSpace(instrument), strings.TrimSpace(value)
+		if !ok || instrument == "" || value == "" {
+			return nil, fmt.Errorf("invalid metric %s %q: expected INSTRUMENT=%s", kind, rule, strings.ToUpper(kind))
+		}
+
+		if _, err := path.Match(instrument, ""); err != nil {
+			return nil, fmt.Errorf("invalid metric %s %q: %w", kind, rule, err)
+		}
+
+		parsed = append(parsed, metricMetadata{instrument: instrument, value: value})
+	}
+
+	return parsed, nil
+}
+
+// matchMetricMetadata returns the value of the first override matching the instrument name.
+func matchMetricMetadata(overrides []metricMetadata, name string) (string, bool) {
+	for _, o := range overrides {
+		if ok, _ := path.Match(o.instrument, name); ok {
+			return o.value, true
+		}
+	}
+
+	return "", false
+}
+
+func startRuntimeMetrics(cfgs *configs.Configs, mp *sdkmetric.MeterProvider) error {
+	interval := cfgs.OTLPConfigs.RuntimeMetricsInterval
+	if interval <= 0 {
+		interval = DefaultRuntimeMetricsInterval
+	}
+
+	err := runtime.Start(
+		runtime.WithMeterProvider(mp),
+		runtime.WithMinimumReadMemStatsInterval(interval),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to start runtime metrics: %w", err)
+	}
+
+	return nil
+}
+
+// registerSpanQueueMetrics registers the observable instruments reporting the state of the span
+// batch queue on the package meter of mp: its current size, its capacity and the number of spans
+// dropped because it was full. They are observed on each collection, giving early warning of
+// export backpressure before spans are dropped.
+func registerSpanQueueMetrics(mp *sdkmetric.MeterProvider, batch *processor.Batch) error {
+	meter := mp.Meter(InstrumentationName, metric.WithSchemaURL(SchemaURL()))
+
+	size, err := meter.Int64ObservableGauge(
+		"otel.sdk.processor.span.queue.size",
+		metric.WithDescription("The number of spans waiting in the batch queue to be exported."),
+		metric.WithUnit("{span}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create span queue size gauge: %w", err)
+	}
+
+	capacity, err := meter.Int64ObservableGauge(
+		"otel.sdk.processor.span.queue.capacity",
+		metric.WithDescription("The maximum number of spans the batch queue can hold."),
+		metric.WithUnit("{span}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create span queue capacity gauge: %w", err)
+	}
+
+	dropped, err := meter.Int64ObservableCounter(
+		"otel.sdk.processor.span.dropped",
+		metric.WithDescription("The number of spans dropped because the batch queue was full."),
+		metric.WithUnit("{span}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create dropped spans counter: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(size, int64(batch.QueueLen()))
+		o.ObserveInt64(capacity, int64(batch.QueueCapacity()))
+		o.ObserveInt64(dropped, int64(batch.Dropped()))
+		return nil
+	}, size, capacity, dropped)
+	if err != nil {
+		return fmt.Errorf("failed to register span queue metrics: %w", err)
+	}
+
+	return nil
+}
+
+// registerConnectionMetrics registers the otel.exporter.grpc.reconnections observable counter on
+// the package meter of mp, reporting the reconnections of the exporter gRPC connections.
+func registerConnectionMetrics(mp *sdkmetric.MeterProvider, monitor *otlpgrpc.ConnectionMonitor) error {
+	meter := mp.Meter(InstrumentationName, metric.WithSchemaURL(SchemaURL()))
+
+	_, err := meter.Int64ObservableCounter(
+		"otel.exporter.grpc.reconnections",
+		metric.WithDescription("The number of reconnections of the exporter gRPC connections."),
+		metric.WithUnit("{connection}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(monitor.Reconnections()))
+			return nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create reconnections counter: %w", err)
+	}
+
+	return nil
+}
+
+// registerSpanExportMetrics registers the otel.exporter.span.failed and otel.exporter.span.oversized
+// observable counters on the package meter of mp, reporting the spans dropped because their export
+// failed and the spans exceeding the size limit.
+func registerSpanExportMetrics(mp *sdkmetric.MeterProvider, observed *exporter.Observed, oversized *exporter.SizeLimited) error {
+	meter := mp.Meter(InstrumentationName, metric.WithSchemaURL(SchemaURL()))
+
+	_, err := meter.Int64ObservableCounter(
+		"otel.exporter.span.failed",
+		metric.WithDescription("The number of spans dropped because their export failed after retries."),
+		metric.WithUnit("{span}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(observed.Stats().FailedSpans))
+			return nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create failed spans counter: %w", err)
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"otel.exporter.span.oversized",
+		metric.WithDescription("The number of spans dropped or truncated because they exceeded the size limit."),
+		metric.WithUnit("{span}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(oversized.Oversized()))
+			return nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create oversized spans counter: %w", err)
+	}
+
+	return nil
+}
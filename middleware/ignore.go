@@ -0,0 +1,48 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package middleware
+
+import (
+	"path"
+	"strings"
+	"sync/atomic"
+)
+
+// DefaultIgnoredPaths are the request paths not traced unless SetIgnoredPaths changes them:
+// the common health check and metrics scraping endpoints.
+var DefaultIgnoredPaths = []string{"/healthz", "/livez", "/readyz", "/metrics"}
+
+var ignoredPaths atomic.Pointer[[]string]
+
+func init() {
+	SetIgnoredPaths(DefaultIgnoredPaths)
+}
+
+// SetIgnoredPaths sets the request paths for which the server middlewares don't create spans,
+// removing the noise and volume of health checks and metrics scraping. A pattern ending with "*"
+// matches the paths starting with what precedes it (e.g. "/debug/*"), other patterns are matched
+// with path.Match (e.g. "/api/*/health"), which is an exact match when they have no wildcard.
+// otel.Setup calls it with OTLPConfigs.IgnoredPaths when set; passing nil traces every request.
+//
+// Parameters:
+//   - patterns: The patterns of the ignored paths
+func SetIgnoredPaths(patterns []string) {
+	patterns = append([]string(nil), patterns...)
+	ignoredPaths.Store(&patterns)
+}
+
+// ignored reports whether requests to p are not traced.
+func ignored(p string) bool {
+	for _, pattern := range *ignoredPaths.Load() {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(p, prefix) {
+			return true
+		}
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+
+	return false
+}
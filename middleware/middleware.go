@@ -0,0 +1,172 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package middleware provides framework-agnostic HTTP server tracing built on *http.Request.
+// The span naming, attribute and status logic lives here once, and thin adapters for
+// net/http (Handler), Echo (otelecho) and Fiber (otelfiber) reuse it, so every framework
+// produces the same telemetry. NewTransport is the client-side counterpart for outgoing requests.
+//
+// Spans are created from the global TracerProvider and trace context is extracted with the
+// global TextMapPropagator, both registered by otel.Setup.
+package middleware
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the spans created by the middlewares.
+const ScopeName = "github.com/goxkit/otel/middleware"
+
+// ServerSpan is the server span of an HTTP request being handled.
+type ServerSpan struct {
+	span   trace.Span
+	method string
+}
+
+// Start extracts the trace context from the request headers and starts a server span for it,
+// carrying the method, scheme, path, host and user agent attributes. The returned request
+// carries the span in its context and must be handed to the next handlers.
+//
+// The span is named after the request method until Finish provides the matched route.
+// Requests to the paths ignored with SetIgnoredPaths are not traced: the request is returned
+// unchanged with a ServerSpan whose Finish does nothing.
+//
+// Parameters:
+//   - r: The incoming request
+//
+// Returns:
+//   - *http.Request: The request whose context carries the span
+//   - *ServerSpan: The span to be finished once the request is handled
+func Start(r *http.Request) (*http.Request, *ServerSpan) {
+	if ignored(r.URL.Path) {
+		return r, &ServerSpan{}
+	}
+
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	ctx, span := tracer().Start(
+		ctx,
+		r.Method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(r.Method),
+			semconv.URLScheme(scheme),
+			semconv.URLPath(r.URL.Path),
+			semconv.ServerAddress(r.Host),
+			semconv.UserAgentOriginal(r.UserAgent()),
+		),
+	)
+
+	return r.WithContext(ctx), &ServerSpan{span: span, method: r.Method}
+}
+
+// Finish names the span after the matched route, records the response status code and
+// the handler error, and ends the span. Responses with a 5xx status code or a non-nil
+// error set the span status to Error.
+//
+// Parameters:
+//   - route: The matched route template (e.g. "/users/{id}"), empty when unknown
+//   - status: The response status code
+//   - err: The error returned by the handler, if any
+func (s *ServerSpan) Finish(route string, status int, err error) {
+	if s.span == nil {
+		return
+	}
+
+	if route != "" {
+		s.span.SetName(s.method + " " + route)
+		s.span.SetAttributes(semconv.HTTPRoute(route))
+	}
+
+	s.span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+
+	switch {
+	case err != nil:
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	case status >= http.StatusInternalServerError:
+		s.span.SetStatus(codes.Error, http.StatusText(status))
+	}
+
+	s.span.End()
+}
+
+// Handler wraps a net/http handler so that each request is traced. The route is taken
+// from the pattern matched by http.ServeMux, without its method and host, so that
+// "GET api.example.com/users/{id}" names the span "GET /users/{id}". The response writer
+// handed to next implements http.Flusher and http.Hijacker when the original one does,
+// and unwraps to it for http.ResponseController.
+//
+// Parameters:
+//   - next: The handler to be traced
+//
+// Returns:
+//   - http.Handler: The tracing handler
+func Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, span := Start(r)
+		rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rw, r)
+
+		span.Finish(routeFromPattern(r.Pattern), rw.status, nil)
+	})
+}
+
+// routeFromPattern strips the optional method and host of an http.ServeMux pattern,
+// written as "[METHOD ][HOST]/[PATH]", returning the path part.
+func routeFromPattern(pattern string) string {
+	if _, rest, ok := strings.Cut(pattern, " "); ok {
+		pattern = strings.TrimLeft(rest, " \t")
+	}
+	if i := strings.IndexByte(pattern, '/'); i > 0 {
+		pattern = pattern[i:]
+	}
+	return pattern
+}
+
+func tracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer(ScopeName, trace.WithSchemaURL(semconv.SchemaURL))
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, http.ErrNotSupported
+}
+
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
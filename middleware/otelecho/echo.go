@@ -0,0 +1,36 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package otelecho adapts the framework-agnostic tracing middleware to Echo.
+package otelecho
+
+import (
+	"github.com/goxkit/otel/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware returns an Echo middleware tracing each request with middleware.Start and
+// ServerSpan.Finish, naming spans after the Echo route path.
+//
+// Errors returned by the next handlers are handed to the Echo HTTP error handler before
+// the span is finished, so that the recorded status code is the one sent to the client.
+//
+// Returns:
+//   - echo.MiddlewareFunc: The tracing middleware
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r, span := middleware.Start(c.Request())
+			c.SetRequest(r)
+
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			span.Finish(c.Path(), c.Response().Status, err)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package otelfiber adapts the framework-agnostic tracing middleware to Fiber.
+package otelfiber
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/goxkit/otel/middleware"
+)
+
+// Middleware returns a Fiber handler tracing each request with middleware.Start and
+// ServerSpan.Finish, naming spans after the Fiber route path. The span-bearing context
+// is available to the next handlers through fiber.Ctx.UserContext.
+//
+// Returns:
+//   - fiber.Handler: The tracing middleware
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		r, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return c.Next()
+		}
+
+		r, span := middleware.Start(r.WithContext(c.UserContext()))
+		c.SetUserContext(r.Context())
+
+		err = c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = http.StatusInternalServerError
+
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		span.Finish(c.Route().Path, status, err)
+		return err
+	}
+}
@@ -9,3 +9,47 @@
 // The package is designed to provide shared components across different observability
 // packages in the Goxkit ecosystem, enabling consistent configuration and setup.
 package otel
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/otlpgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// logger returns the application logger, falling back to a no-op logger
+// when the configurations don't carry one.
+func logger(cfgs *configs.Configs) *zap.Logger {
+	if cfgs.Logger == nil {
+		return zap.NewNop()
+	}
+
+	return cfgs.Logger
+}
+
+// signalAttributes parses the comma-separated key=value pairs of a per-signal static attributes
+// setting, such as OTLPConfigs.TraceAttributes, sorted by key so that they are stable.
+func signalAttributes(pairs string) []attribute.KeyValue {
+	parsed := otlpgrpc.ParseHeaders(pairs)
+
+	attrs := make([]attribute.KeyValue, 0, len(parsed))
+	for _, key := range slices.Sorted(maps.Keys(parsed)) {
+		attrs = append(attrs, attribute.String(key, parsed[key]))
+	}
+
+	return attrs
+}
+
+// attributeKeys converts the attribute names of a setting, such as
+// OTLPConfigs.ResourceMetricAttributes, into attribute keys.
+func attributeKeys(names []string) []attribute.Key {
+	keys := make([]attribute.Key, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, attribute.Key(name))
+	}
+
+	return keys
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package channelz exposes the gRPC channelz service, giving deep visibility into the exporter
+// connections, their subchannels and socket statistics, e.g. to debug export stalls with grpcdebug.
+//
+// Importing this package turns channelz collection on for every gRPC connection of the process,
+// which has a runtime overhead; this is a grpc-go behavior, and the reason why it lives apart from
+// the otel and otlpgrpc packages. Only import it in binaries where OTLPConfigs.EnableChannelz may
+// be set.
+package channelz
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/goxkit/configs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/channelz/service"
+)
+
+// DefaultAddress is the address the channelz debug server listens on when
+// OTLPConfigs.ChannelzAddress is not set. It only accepts local connections.
+const DefaultAddress = "localhost:6061"
+
+// Register registers the channelz service on a gRPC server, typically an existing debug server.
+//
+// Parameters:
+//   - s: The server the service is registered on
+func Register(s grpc.ServiceRegistrar) {
+	service.RegisterChannelzServiceToServer(s)
+}
+
+// Start starts a gRPC debug server exposing the channelz service on OTLPConfigs.ChannelzAddress,
+// or DefaultAddress, when OTLPConfigs.EnableChannelz is set. The server must be stopped by the
+// caller, e.g. with GracefulStop during shutdown.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - *grpc.Server: The debug server, nil when channelz is not enabled
+//   - error: Any error encountered while listening on the address
+func Start(cfgs *configs.Configs) (*grpc.Server, error) {
+	if !cfgs.OTLPConfigs.EnableChannelz {
+		return nil, nil
+	}
+
+	addr := cfgs.OTLPConfigs.ChannelzAddress
+	if addr == "" {
+		addr = DefaultAddress
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on the channelz address %q: %w", addr, err)
+	}
+
+	s := grpc.NewServer()
+	Register(s)
+
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	return s, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	// Registers the standard gzip codec, decompressing gzip responses.
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+// CompressionGzip is the name of the gzip compression supported by OTLP collectors.
+const CompressionGzip = "gzip"
+
+// Full names of the OTLP export methods, telling the signal of an export call apart.
+const (
+	traceExportMethod  = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+	metricExportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+	logExportMethod    = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+)
+
+// compressionOptions returns the dial options compressing export calls as described by
+// the options, validating the compressions and the level.
+func compressionOptions(opts Options) ([]grpc.DialOption, error) {
+	compressions := map[string]string{
+		traceExportMethod:  signalCompression(opts.TracesCompression, opts.Compression),
+		metricExportMethod: signalCompression(opts.MetricsCompression, opts.Compression),
+		logExportMethod:    signalCompression(opts.LogsCompression, opts.Compression),
+	}
+
+	gzipped := false
+	for _, compression := range append(slices.Collect(maps.Values(compressions)), opts.Compression) {
+		switch compression {
+		case "", "none":
+		case CompressionGzip:
+			gzipped = true
+		default:
+			return nil, fmt.Errorf("unsupported otel exporter compression %q", compression)
+		}
+	}
+
+	if !gzipped {
+		return nil, nil
+	}
+
+	if level := opts.CompressionLevel; level != 0 && (level < gzip.BestSpeed || level > gzip.BestCompression) {
+		return nil, fmt.Errorf("invalid otel exporter compression level %d, expected 1 to 9", opts.CompressionLevel)
+	}
+
+	if err := registerGzipLevel(opts.CompressionLevel); err != nil {
+		return nil, err
+	}
+
+	return []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(CompressionGzip)),
+		grpc.WithChainUnaryInterceptor(compressionInterceptor(opts.Compression, compressions)),
+	}, nil
+}
+
+var (
+	gzipLevelMu sync.Mutex
+	// gzipLevel is the compression level registered for the process, 0 while the standard
+	// gzip compressor is in use.
+	gzipLevel int
+)
+
+// registerGzipLevel registers a gzip compressor compressing at level, unless level is 0.
+// Collectors only accept the standard "gzip" name, so the compressor replaces the one of
+// the whole process and exporters can't ask for different levels.
+func registerGzipLevel(level int) error {
+	if level == 0 {
+		return nil
+	}
+
+	gzipLevelMu.Lock()
+	defer gzipLevelMu.Unlock()
+
+	switch gzipLevel {
+	case level:
+		return nil
+	case 0:
+		encoding.RegisterCompressor(newGzipCompressor(level))
+		gzipLevel = level
+		return nil
+	default:
+		return fmt.Errorf("otel exporter compression level %d conflicts with the level %d already registered", level, gzipLevel)
+	}
+}
+
+// gzipCompressor is a gzip encoding.Compressor compressing at a given level.
+type gzipCompressor struct {
+	writers sync.Pool
+}
+
+func newGzipCompressor(level int) *gzipCompressor {
+	c := &gzipCompressor{}
+	c.writers.New = func() any {
+		// The level is validated by compressionOptions.
+		w, _ := gzip.NewWriterLevel(io.Discard, level)
+		return &gzipWriter{Writer: w, pool: &c.writers}
+	}
+
+	return c
+}
+
+// Compress implements encoding.Compressor.
+func (c *gzipCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	z := c.writers.Get().(*gzipWriter)
+	z.Reset(w)
+
+	return z, nil
+}
+
+// Decompress implements encoding.Compressor.
+func (c *gzipCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+// Name implements encoding.Compressor.
+func (c *gzipCompressor) Name() string {
+	return CompressionGzip
+}
+
+// gzipWriter returns its gzip.Writer to the pool once closed.
+type gzipWriter struct {
+	*gzip.Writer
+	pool *sync.Pool
+}
+
+func (w *gzipWriter) Close() error {
+	defer w.pool.Put(w)
+
+	return w.Writer.Close()
+}
+
+// signalCompression returns the compression of a signal, falling back to the compression of
+// every signal when unset.
+func signalCompression(compression, fallback string) string {
+	if compression == "" {
+		return fallback
+	}
+
+	return compression
+}
+
+// compressionInterceptor compresses each call with the compression of its method, or with
+// fallback for the other methods: the default gzip call option of the connection applies
+// unless the call opts out with the identity compression.
+func compressionInterceptor(fallback string, compressions map[string]string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		compression, ok := compressions[method]
+		if !ok {
+			compression = fallback
+		}
+
+		if compression != CompressionGzip {
+			opts = append(opts, grpc.UseCompressor(encoding.Identity))
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// encodingRecorder is a server stats.Handler recording the grpc-encoding of each method called.
+type encodingRecorder struct {
+	mu        sync.Mutex
+	encodings map[string]string
+}
+
+func (r *encodingRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *encodingRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if header, ok := s.(*stats.InHeader); ok {
+		r.mu.Lock()
+		r.encodings[header.FullMethod] = header.Compression
+		r.mu.Unlock()
+	}
+}
+
+func (r *encodingRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *encodingRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+// exportHandler answers every call as an OTLP trace export.
+func exportHandler(_ any, stream grpc.ServerStream) error {
+	if err := stream.RecvMsg(&coltracepb.ExportTraceServiceRequest{}); err != nil {
+		return err
+	}
+	return stream.SendMsg(&coltracepb.ExportTraceServiceResponse{})
+}
+
+func TestCompressionPerSignal(t *testing.T) {
+	recorder := &encodingRecorder{encodings: map[string]string{}}
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnknownServiceHandler(exportHandler), grpc.StatsHandler(recorder))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := NewExporterGRPCClientWithOptions(Options{
+		Endpoint:           "passthrough:///bufnet",
+		Compression:        CompressionGzip,
+		MetricsCompression: "none",
+		CompressionLevel:   9,
+		DialOptions: []grpc.DialOption{grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})},
+	})
+	if err != nil {
+		t.Fatalf("NewExporterGRPCClientWithOptions() error = %v, want nil", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	for _, method := range []string{traceExportMethod, metricExportMethod, logExportMethod} {
+		err := conn.Invoke(context.Background(), method, &coltracepb.ExportTraceServiceRequest{}, &coltracepb.ExportTraceServiceResponse{})
+		if err != nil {
+			t.Fatalf("Invoke(%s) error = %v, want nil", method, err)
+		}
+	}
+
+	want := map[string]string{traceExportMethod: "gzip", metricExportMethod: "identity", logExportMethod: "gzip"}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for method, encoding := range want {
+		if got := recorder.encodings[method]; got != encoding {
+			t.Errorf("grpc-encoding of %s = %q, want %q", method, got, encoding)
+		}
+	}
+}
+
+func TestRegisterGzipLevel(t *testing.T) {
+	if err := registerGzipLevel(9); err != nil {
+		t.Fatalf("registerGzipLevel(9) error = %v, want nil", err)
+	}
+	if err := registerGzipLevel(9); err != nil {
+		t.Errorf("registerGzipLevel(9) again error = %v, want nil", err)
+	}
+	if err := registerGzipLevel(0); err != nil {
+		t.Errorf("registerGzipLevel(0) error = %v, want nil", err)
+	}
+	if err := registerGzipLevel(1); err == nil {
+		t.Error("registerGzipLevel(1) after level 9 error = nil, want a conflict")
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goxkit/configs"
+)
+
+// LoadCredentials sets the mutual TLS client certificate and the bearer token provider of opts
+// from the OTLPConfigs.ExporterClientCertificate and OTLPConfigs.ExporterClientKey files and the
+// OTLPConfigs.ExporterTokenFile file, which NewOptions leaves unset as reading them can fail.
+// Unset files leave the matching options untouched.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//   - opts: The options to be completed
+//
+// Returns:
+//   - error: An error if only one of the certificate and key files is set or a file can't be loaded
+func LoadCredentials(cfgs *configs.Configs, opts *Options) error {
+	certFile, keyFile := cfgs.OTLPConfigs.ExporterClientCertificate, cfgs.OTLPConfigs.ExporterClientKey
+	if (certFile == "") != (keyFile == "") {
+		return fmt.Errorf("invalid otel exporter client certificate: both the certificate and key files must be set")
+	}
+
+	if certFile != "" {
+		getCertificate, err := FileClientCertificate(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		opts.GetClientCertificate = getCertificate
+	}
+
+	if cfgs.OTLPConfigs.ExporterTokenFile != "" {
+		token, err := FileToken(cfgs.OTLPConfigs.ExporterTokenFile)
+		if err != nil {
+			return err
+		}
+		opts.TokenProvider = token
+	}
+
+	return nil
+}
+
+// FileClientCertificate returns a function suitable for Options.GetClientCertificate, presenting
+// the PEM encoded certificate and key pair read from files. The pair is loaded again on the first
+// handshake following a change of either file, so that short-lived certificates rotated on disk
+// are used from the next reconnection.
+//
+// Parameters:
+//   - certFile: The path of the PEM encoded certificate chain
+//   - keyFile: The path of the PEM encoded private key
+//
+// Returns:
+//   - func(*tls.CertificateRequestInfo) (*tls.Certificate, error): The certificate getter
+//   - error: An error if the pair can't be loaded
+func FileClientCertificate(certFile, keyFile string) (func(*tls.CertificateRequestInfo) (*tls.Certificate, error), error) {
+	r := &fileReloader[*tls.Certificate]{
+		paths: []string{certFile, keyFile},
+		load: func() (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load the otel exporter client certificate: %w", err)
+			}
+			return &cert, nil
+		},
+	}
+	if _, err := r.get(); err != nil {
+		return nil, err
+	}
+
+	return func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return r.get()
+	}, nil
+}
+
+// FileToken returns a function suitable for Options.TokenProvider, reading the bearer token from
+// a file, e.g. a short-lived JWT projected by the platform. The file is read again on the first
+// export call following a change of the file, so that refreshed tokens are used right away.
+//
+// Parameters:
+//   - path: The path of the file holding the token, surrounding spaces being trimmed
+//
+// Returns:
+//   - func(ctx context.Context) (string, error): The token provider
+//   - error: An error if the file can't be read or is empty
+func FileToken(path string) (func(ctx context.Context) (string, error), error) {
+	r := &fileReloader[string]{
+		paths: []string{path},
+		load: func() (string, error) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("failed to read the otel exporter token: %w", err)
+			}
+			token := strings.TrimSpace(string(data))
+			if token == "" {
+				return "", fmt.Errorf("failed to read the otel exporter token: %q is empty", path)
+			}
+			return token, nil
+		},
+	}
+	if _, err := r.get(); err != nil {
+		return nil, err
+	}
+
+	return func(context.Context) (string, error) {
+		return r.get()
+	}, nil
+}
+
+// fileReloader caches a value loaded from files until their modification times change.
+type fileReloader[T any] struct {
+	paths []string
+	load  func() (T, error)
+
+	mu       sync.Mutex
+	modTimes []time.Time
+	value    T
+}
+
+func (r *fileReloader[T]) get() (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTimes := make([]time.Time, 0, len(r.paths))
+	for _, path := range r.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			var zero T
+			return zero, fmt.Errorf("failed to stat %q: %w", path, err)
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	if r.modTimes != nil && slices.EqualFunc(modTimes, r.modTimes, time.Time.Equal) {
+		return r.value, nil
+	}
+
+	value, err := r.load()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	r.value, r.modTimes = value, modTimes
+
+	return value, nil
+}
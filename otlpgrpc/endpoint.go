@@ -0,0 +1,66 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NormalizeEndpoint turns an endpoint written as a URL, such as "https://collector:4317/", into
+// the host:port target expected by gRPC, reporting the TLS setting implied by its scheme:
+// "https" and "grpcs" imply TLS, "http" doesn't, while "grpc" and scheme-less endpoints imply
+// nothing. Other gRPC targets, such as "dns:///collector:4317" or "unix:///run/otel.sock",
+// are returned unchanged.
+//
+// Parameters:
+//   - endpoint: The configured endpoint
+//
+// Returns:
+//   - string: The gRPC dial target
+//   - bool: Whether the scheme implies TLS
+//   - bool: Whether the scheme implies a TLS setting at all
+func NormalizeEndpoint(endpoint string) (string, bool, bool) {
+	endpoint = strings.TrimSpace(endpoint)
+
+	scheme, _, ok := strings.Cut(endpoint, "://")
+	if !ok {
+		return endpoint, false, false
+	}
+
+	var tls, implied bool
+	switch strings.ToLower(scheme) {
+	case "https", "grpcs":
+		tls, implied = true, true
+	case "http":
+		tls, implied = false, true
+	case "grpc":
+	default:
+		return endpoint, false, false
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint, tls, implied
+	}
+
+	return u.Host, tls, implied
+}
+
+// ParseEndpointSpec parses an endpoint spec written as "ENDPOINT|KEY=VALUE;KEY=VALUE", describing
+// an additional collector with its own headers, e.g. "https://archive:4317|x-api-key=secret".
+// The headers part is optional, and the endpoint accepts the forms handled by NormalizeEndpoint.
+//
+// Parameters:
+//   - spec: The endpoint spec
+//
+// Returns:
+//   - string: The endpoint
+//   - map[string]string: The headers
+func ParseEndpointSpec(spec string) (string, map[string]string) {
+	endpoint, headers, _ := strings.Cut(spec, "|")
+
+	return strings.TrimSpace(endpoint), ParseHeaders(strings.ReplaceAll(headers, ";", ","))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultEndpoint is the OTLP/gRPC collector address used without TLS, matching the specification
+// default "http://localhost:4317", when OTEL_EXPORTER_OTLP_ENDPOINT is not set.
+const DefaultEndpoint = "localhost:4317"
+
+// LoadFromEnv reads the connection options from the standard OpenTelemetry exporter environment
+// variables, so that the package can be driven entirely by them without configs.Configs:
+//   - OTEL_EXPORTER_OTLP_ENDPOINT: the collector endpoint, an http:// scheme disabling TLS and an
+//     https:// scheme enabling it (see NormalizeEndpoint, defaults to DefaultEndpoint without TLS),
+//     schemes other than http, https, grpc and grpcs being rejected;
+//   - OTEL_EXPORTER_OTLP_HEADERS: comma-separated, percent-encoded key=value headers (see
+//     ParseHeaders), sent over plaintext http:// endpoints too unless RequireSecureHeaders is set
+//     on the returned options;
+//   - OTEL_EXPORTER_OTLP_PROTOCOL: the exporter protocol, which must be "grpc";
+//   - OTEL_EXPORTER_OTLP_COMPRESSION: "gzip" or "none", overridden per signal by
+//     OTEL_EXPORTER_OTLP_TRACES_COMPRESSION, OTEL_EXPORTER_OTLP_METRICS_COMPRESSION and
+//     OTEL_EXPORTER_OTLP_LOGS_COMPRESSION;
+//   - OTEL_EXPORTER_OTLP_TIMEOUT: the export call timeout in milliseconds;
+//   - OTEL_EXPORTER_OTLP_INSECURE: "true" to disable TLS when the endpoint has no scheme, which
+//     otherwise enables it;
+//   - OTEL_EXPORTER_OTLP_CERTIFICATE: the path of the PEM encoded CA certificates used to verify
+//     the collector, the system roots being used when unset;
+//   - OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE and OTEL_EXPORTER_OTLP_CLIENT_KEY: the paths of the
+//     PEM encoded client certificate and key presented for mutual TLS (see FileClientCertificate).
+//
+// Returns:
+//   - Options: The connection options
+//   - error: An error naming the variable holding an invalid value
+func LoadFromEnv() (Options, error) {
+	opts := Options{
+		Endpoint: DefaultEndpoint,
+		Headers:  ParseHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")),
+	}
+
+	insecure := false
+	if v, ok := lookupEnv("OTEL_EXPORTER_OTLP_INSECURE"); ok {
+		var err error
+		if insecure, err = strconv.ParseBool(v); err != nil {
+			return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_INSECURE %q: expected true or false", v)
+		}
+	}
+
+	if v, ok := lookupEnv("OTEL_EXPORTER_OTLP_ENDPOINT"); ok {
+		if scheme, _, ok := strings.Cut(v, "://"); ok {
+			switch strings.ToLower(scheme) {
+			case "http", "https", "grpc", "grpcs":
+			default:
+				return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_ENDPOINT %q: unsupported scheme %q, expected http, https, grpc or grpcs", v, scheme)
+			}
+		}
+
+		target, tls, implied := NormalizeEndpoint(v)
+		if strings.Contains(target, "://") {
+			return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_ENDPOINT %q: expected host:port or a URL with a host", v)
+		}
+
+		opts.Endpoint = target
+		opts.TLSEnabled = !insecure
+		if implied {
+			opts.TLSEnabled = tls
+		}
+	}
+
+	if v, ok := lookupEnv("OTEL_EXPORTER_OTLP_PROTOCOL"); ok && v != "grpc" {
+		return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_PROTOCOL %q: only grpc is supported", v)
+	}
+
+	compressions := []struct {
+		key         string
+		compression *string
+	}{
+		{"OTEL_EXPORTER_OTLP_COMPRESSION", &opts.Compression},
+		{"OTEL_EXPORTER_OTLP_TRACES_COMPRESSION", &opts.TracesCompression},
+		{"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION", &opts.MetricsCompression},
+		{"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION", &opts.LogsCompression},
+	}
+	for _, c := range compressions {
+		if v, ok := lookupEnv(c.key); ok {
+			if v != CompressionGzip && v != "none" {
+				return Options{}, fmt.Errorf("invalid %s %q: expected gzip or none", c.key, v)
+			}
+			*c.compression = v
+		}
+	}
+
+	if v, ok := lookupEnv("OTEL_EXPORTER_OTLP_TIMEOUT"); ok {
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms < 0 {
+			return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_TIMEOUT %q: expected a number of milliseconds", v)
+		}
+		opts.Timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	if v, ok := lookupEnv("OTEL_EXPORTER_OTLP_CERTIFICATE"); ok {
+		pem, err := os.ReadFile(v)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_CERTIFICATE: %w", err)
+		}
+
+		opts.RootCAs = x509.NewCertPool()
+		if !opts.RootCAs.AppendCertsFromPEM(pem) {
+			return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_CERTIFICATE %q: no PEM encoded certificate found", v)
+		}
+	}
+
+	certFile, hasCert := lookupEnv("OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE")
+	keyFile, hasKey := lookupEnv("OTEL_EXPORTER_OTLP_CLIENT_KEY")
+	if hasCert != hasKey {
+		return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE and OTEL_EXPORTER_OTLP_CLIENT_KEY: both must be set")
+	}
+	if hasCert {
+		getCertificate, err := FileClientCertificate(certFile, keyFile)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE: %w", err)
+		}
+		opts.GetClientCertificate = getCertificate
+	}
+
+	return opts, nil
+}
+
+// lookupEnv returns the trimmed value of the environment variable, reporting
+// whether it is set to a non-blank value.
+func lookupEnv(key string) (string, bool) {
+	v := strings.TrimSpace(os.Getenv(key))
+	return v, v != ""
+}
@@ -0,0 +1,341 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var envKeys = []string{
+	"OTEL_EXPORTER_OTLP_ENDPOINT",
+	"OTEL_EXPORTER_OTLP_HEADERS",
+	"OTEL_EXPORTER_OTLP_PROTOCOL",
+	"OTEL_EXPORTER_OTLP_COMPRESSION",
+	"OTEL_EXPORTER_OTLP_TRACES_COMPRESSION",
+	"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION",
+	"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION",
+	"OTEL_EXPORTER_OTLP_TIMEOUT",
+	"OTEL_EXPORTER_OTLP_INSECURE",
+	"OTEL_EXPORTER_OTLP_CERTIFICATE",
+	"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE",
+	"OTEL_EXPORTER_OTLP_CLIENT_KEY",
+}
+
+// setEnv clears every variable read by LoadFromEnv, then sets the given ones.
+func setEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for _, key := range envKeys {
+		t.Setenv(key, "")
+	}
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+}
+
+// writeCertificate writes a self-signed PEM encoded certificate and its key to dir.
+func writeCertificate(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v, want nil", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "otel-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v, want nil", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey() error = %v, want nil", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certFile, keyFile
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v, want nil", err)
+	}
+}
+
+func TestLoadFromEnvDefaults(t *testing.T) {
+	setEnv(t, nil)
+
+	opts, err := LoadFromEnv()
+	if err != nil {
+		t.Fatalf("LoadFromEnv() error = %v, want nil", err)
+	}
+	if opts.Endpoint != DefaultEndpoint {
+		t.Errorf("Endpoint = %q, want %q", opts.Endpoint, DefaultEndpoint)
+	}
+	if opts.TLSEnabled {
+		t.Error("TLSEnabled = true, want false for the default endpoint")
+	}
+	if opts.RootCAs != nil {
+		t.Error("RootCAs != nil, want nil so that the system roots are used")
+	}
+	if opts.Timeout != 0 || opts.Compression != "" || len(opts.Headers) != 0 || opts.GetClientCertificate != nil {
+		t.Errorf("LoadFromEnv() = %+v, want no timeout, compression, headers or client certificate", opts)
+	}
+}
+
+func TestLoadFromEnvEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		insecure string
+		want     string
+		wantTLS  bool
+	}{
+		{name: "http", endpoint: "http://collector:4317", want: "collector:4317", wantTLS: false},
+		{name: "https", endpoint: "https://collector:4317", want: "collector:4317", wantTLS: true},
+		{name: "https overrides insecure", endpoint: "https://collector:4317", insecure: "true", want: "collector:4317", wantTLS: true},
+		{name: "http overrides secure", endpoint: "http://collector:4317", insecure: "false", want: "collector:4317", wantTLS: false},
+		{name: "upper case scheme", endpoint: "HTTPS://collector:4317", want: "collector:4317", wantTLS: true},
+		{name: "grpcs", endpoint: "grpcs://collector:4317", want: "collector:4317", wantTLS: true},
+		{name: "grpc", endpoint: "grpc://collector:4317", want: "collector:4317", wantTLS: true},
+		{name: "grpc insecure", endpoint: "grpc://collector:4317", insecure: "true", want: "collector:4317", wantTLS: false},
+		{name: "no scheme", endpoint: "collector:4317", want: "collector:4317", wantTLS: true},
+		{name: "no scheme insecure", endpoint: "collector:4317", insecure: "true", want: "collector:4317", wantTLS: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": tt.endpoint,
+				"OTEL_EXPORTER_OTLP_INSECURE": tt.insecure,
+			})
+
+			opts, err := LoadFromEnv()
+			if err != nil {
+				t.Fatalf("LoadFromEnv() error = %v, want nil", err)
+			}
+			if opts.Endpoint != tt.want {
+				t.Errorf("Endpoint = %q, want %q", opts.Endpoint, tt.want)
+			}
+			if opts.TLSEnabled != tt.wantTLS {
+				t.Errorf("TLSEnabled = %v, want %v", opts.TLSEnabled, tt.wantTLS)
+			}
+		})
+	}
+}
+
+func TestLoadFromEnvValues(t *testing.T) {
+	certFile, keyFile := writeCertificate(t, t.TempDir())
+	setEnv(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_HEADERS":             "api-key=secret,tenant=acme",
+		"OTEL_EXPORTER_OTLP_PROTOCOL":            "grpc",
+		"OTEL_EXPORTER_OTLP_COMPRESSION":         "gzip",
+		"OTEL_EXPORTER_OTLP_TRACES_COMPRESSION":  "none",
+		"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION": "gzip",
+		"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION":    "none",
+		"OTEL_EXPORTER_OTLP_TIMEOUT":             "2500",
+		"OTEL_EXPORTER_OTLP_CERTIFICATE":         certFile,
+		"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE":  certFile,
+		"OTEL_EXPORTER_OTLP_CLIENT_KEY":          keyFile,
+	})
+
+	opts, err := LoadFromEnv()
+	if err != nil {
+		t.Fatalf("LoadFromEnv() error = %v, want nil", err)
+	}
+	if opts.Headers["api-key"] != "secret" || opts.Headers["tenant"] != "acme" {
+		t.Errorf("Headers = %v, want api-key=secret and tenant=acme", opts.Headers)
+	}
+	if opts.Compression != "gzip" || opts.TracesCompression != "none" ||
+		opts.MetricsCompression != "gzip" || opts.LogsCompression != "none" {
+		t.Errorf("compressions = %q/%q/%q/%q, want gzip/none/gzip/none",
+			opts.Compression, opts.TracesCompression, opts.MetricsCompression, opts.LogsCompression)
+	}
+	if opts.Timeout != 2500*time.Millisecond {
+		t.Errorf("Timeout = %v, want 2.5s", opts.Timeout)
+	}
+	if opts.RootCAs == nil {
+		t.Error("RootCAs = nil, want the certificate pool")
+	}
+	if opts.GetClientCertificate == nil {
+		t.Fatal("GetClientCertificate = nil, want the client certificate")
+	}
+	if cert, err := opts.GetClientCertificate(nil); err != nil || cert == nil {
+		t.Errorf("GetClientCertificate() = %v, %v, want the certificate", cert, err)
+	}
+}
+
+func TestLoadFromEnvInvalid(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir)
+	notPEM := filepath.Join(dir, "not.pem")
+	writeFile(t, notPEM, []byte("not a certificate"))
+	missing := filepath.Join(dir, "missing.pem")
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{
+			name: "endpoint scheme",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "ftp://collector:4317"},
+			want: "OTEL_EXPORTER_OTLP_ENDPOINT",
+		},
+		{
+			name: "endpoint target scheme",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "dns:///collector:4317"},
+			want: "OTEL_EXPORTER_OTLP_ENDPOINT",
+		},
+		{
+			name: "endpoint without host",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "https://"},
+			want: "OTEL_EXPORTER_OTLP_ENDPOINT",
+		},
+		{
+			name: "insecure",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_INSECURE": "maybe"},
+			want: "OTEL_EXPORTER_OTLP_INSECURE",
+		},
+		{
+			name: "protocol",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "http/protobuf"},
+			want: "OTEL_EXPORTER_OTLP_PROTOCOL",
+		},
+		{
+			name: "compression",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_COMPRESSION": "zstd"},
+			want: "OTEL_EXPORTER_OTLP_COMPRESSION",
+		},
+		{
+			name: "traces compression",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_TRACES_COMPRESSION": "zstd"},
+			want: "OTEL_EXPORTER_OTLP_TRACES_COMPRESSION",
+		},
+		{
+			name: "metrics compression",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION": "zstd"},
+			want: "OTEL_EXPORTER_OTLP_METRICS_COMPRESSION",
+		},
+		{
+			name: "logs compression",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION": "zstd"},
+			want: "OTEL_EXPORTER_OTLP_LOGS_COMPRESSION",
+		},
+		{
+			name: "timeout",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_TIMEOUT": "10s"},
+			want: "OTEL_EXPORTER_OTLP_TIMEOUT",
+		},
+		{
+			name: "negative timeout",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_TIMEOUT": "-1"},
+			want: "OTEL_EXPORTER_OTLP_TIMEOUT",
+		},
+		{
+			name: "missing certificate",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_CERTIFICATE": missing},
+			want: "OTEL_EXPORTER_OTLP_CERTIFICATE",
+		},
+		{
+			name: "certificate not PEM",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_CERTIFICATE": notPEM},
+			want: "OTEL_EXPORTER_OTLP_CERTIFICATE",
+		},
+		{
+			name: "client certificate without key",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": certFile},
+			want: "OTEL_EXPORTER_OTLP_CLIENT_KEY",
+		},
+		{
+			name: "client key without certificate",
+			env:  map[string]string{"OTEL_EXPORTER_OTLP_CLIENT_KEY": keyFile},
+			want: "OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE",
+		},
+		{
+			name: "client certificate not PEM",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": notPEM,
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":         keyFile,
+			},
+			want: "OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv(t, tt.env)
+
+			if _, err := LoadFromEnv(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadFromEnv() error = %v, want an error naming %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromEnvHeadersPercentDecoding(t *testing.T) {
+	setEnv(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_HEADERS": "Authorization=Bearer%20token,x%2Dtenant=acme%2Cinc,bad=%zz,%zz=bad",
+	})
+
+	opts, err := LoadFromEnv()
+	if err != nil {
+		t.Fatalf("LoadFromEnv() error = %v, want nil", err)
+	}
+
+	want := map[string]string{"Authorization": "Bearer token", "x-tenant": "acme,inc"}
+	if len(opts.Headers) != len(want) {
+		t.Errorf("Headers = %v, want %v", opts.Headers, want)
+	}
+	for key, value := range want {
+		if opts.Headers[key] != value {
+			t.Errorf("Headers[%q] = %q, want %q", key, opts.Headers[key], value)
+		}
+	}
+}
+
+func TestLoadFromEnvPlaintextEndpointWithHeaders(t *testing.T) {
+	setEnv(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4317",
+		"OTEL_EXPORTER_OTLP_HEADERS":  "x-api-key=secret",
+	})
+
+	opts, err := LoadFromEnv()
+	if err != nil {
+		t.Fatalf("LoadFromEnv() error = %v, want nil", err)
+	}
+
+	conn, err := NewExporterGRPCClientWithOptions(opts)
+	if err != nil {
+		t.Fatalf("NewExporterGRPCClientWithOptions() error = %v, want nil for a plaintext endpoint with headers", err)
+	}
+	_ = conn.Close()
+
+	opts.RequireSecureHeaders = true
+	if _, err := NewExporterGRPCClientWithOptions(opts); !errors.Is(err, ErrInsecureCredentials) {
+		t.Errorf("NewExporterGRPCClientWithOptions() with RequireSecureHeaders error = %v, want ErrInsecureCredentials", err)
+	}
+}
@@ -9,9 +9,10 @@ package otlpgrpc
 
 import (
 	"context"
-	"crypto/x509"
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"strings"
+	"maps"
 	"time"
 
 	"github.com/goxkit/configs"
@@ -24,27 +25,55 @@ import (
 
 // NewExporterGRPCClient creates a new gRPC client connection for OpenTelemetry OTLP exporters
 // with configurations optimized for telemetry data export. The connection is configured with:
-//   - Insecure credentials (for non-TLS connections)
+//   - Insecure credentials (for non-TLS connections), or TLS with an optional client certificate
+//   - Optional bearer token authentication, combined with the client certificate for mutual TLS
 //   - Idle timeout from configuration
 //   - Keepalive parameters for maintaining long-lived connections
 //   - Exponential backoff strategy for reconnection attempts
+//   - Optional read/write buffer sizes for high-throughput exports
+//   - Optional gzip compression with a configurable level, trading CPU for bandwidth, chosen per signal
+//   - Optional wait-for-ready export calls, riding out brief collector disconnects
+//   - A bounded TLS handshake, failing connection attempts stalled by the peer
 //
 // Parameters:
 //   - cfgs: Application configurations containing OTLP settings
+//   - dialOpts: Additional dial options overriding the configured ones (see Options.DialOptions)
 //
 // Returns:
 //   - *grpc.ClientConn: The configured gRPC client connection
 //   - error: Any error encountered during connection setup
-func NewExporterGRPCClient(cfgs *configs.Configs) (*grpc.ClientConn, error) {
+func NewExporterGRPCClient(cfgs *configs.Configs, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts := NewOptions(cfgs)
+	if err := LoadCredentials(cfgs, &opts); err != nil {
+		return nil, err
+	}
+	opts.DialOptions = dialOpts
+
+	return NewExporterGRPCClientWithOptions(opts)
+}
+
+// NewExporterGRPCClientWithOptions creates a new gRPC client connection for OpenTelemetry OTLP
+// exporters as NewExporterGRPCClient, from explicit Options rather than configs.Configs.
+//
+// Parameters:
+//   - opts: The connection options
+//
+// Returns:
+//   - *grpc.ClientConn: The configured gRPC client connection
+//   - error: Any error encountered during connection setup, including an ErrInsecureCredentials
+//     error (see checkTransportSecurity)
+func NewExporterGRPCClientWithOptions(opts Options) (*grpc.ClientConn, error) {
+	if err := checkTransportSecurity(opts); err != nil {
+		return nil, err
+	}
 
-	conn, err := grpc.NewClient(
-		cfgs.OTLPConfigs.Endpoint,
-		grpc.WithTransportCredentials(evaluateCredentials(cfgs)),
-		grpc.WithPerRPCCredentials(newPerRPCCredentials(cfgs)),
-		grpc.WithIdleTimeout(cfgs.OTLPConfigs.ExporterIdleTimeout),
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(evaluateCredentials(opts)),
+		grpc.WithPerRPCCredentials(newPerRPCCredentials(opts)),
+		grpc.WithIdleTimeout(opts.IdleTimeout),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
-			Time:    cfgs.OTLPConfigs.ExporterKeepAliveTime,
-			Timeout: cfgs.OTLPConfigs.ExporterKeepAliveTimeout,
+			Time:    opts.KeepAliveTime,
+			Timeout: opts.KeepAliveTimeout,
 		}),
 		grpc.WithConnectParams(grpc.ConnectParams{
 			Backoff: backoff.Config{
@@ -54,7 +83,34 @@ func NewExporterGRPCClient(cfgs *configs.Configs) (*grpc.ClientConn, error) {
 			},
 			MinConnectTimeout: 0,
 		}),
-	)
+	}
+	dialOpts = append(dialOpts, bufferOptions(opts)...)
+
+	if opts.Timeout > 0 {
+		dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(timeoutInterceptor(opts.Timeout)))
+	}
+
+	if opts.WaitForReady {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	}
+
+	if opts.Monitor != nil {
+		dialOpts = append(dialOpts, grpc.WithStatsHandler(opts.Monitor.handler()))
+	}
+
+	if len(opts.Resolvers) > 0 {
+		dialOpts = append(dialOpts, grpc.WithResolvers(opts.Resolvers...))
+	}
+
+	compression, err := compressionOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	dialOpts = append(dialOpts, compression...)
+	dialOpts = append(dialOpts, opts.DialOptions...)
+
+	target, _, _ := NormalizeEndpoint(opts.Endpoint)
+	conn, err := grpc.NewClient(target, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create otel exporter gRPC conn: %w", err)
 	}
@@ -62,47 +118,177 @@ func NewExporterGRPCClient(cfgs *configs.Configs) (*grpc.ClientConn, error) {
 	return conn, err
 }
 
-func evaluateCredentials(cfgs *configs.Configs) credentials.TransportCredentials {
-	if !cfgs.OTLPConfigs.ExporterTLSEnabled {
+// NewExporterGRPCClientPool creates OTLPConfigs.ExporterConnectionPoolSize gRPC client connections
+// configured as in NewExporterGRPCClient, so that exports can be spread across several HTTP/2
+// connections at high span rates. A single connection is created when the pool size is not set.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//   - dialOpts: Additional dial options overriding the configured ones (see Options.DialOptions)
+//
+// Returns:
+//   - []*grpc.ClientConn: The configured gRPC client connections
+//   - error: Any error encountered during connection setup, in which case no connection is left open
+func NewExporterGRPCClientPool(cfgs *configs.Configs, dialOpts ...grpc.DialOption) ([]*grpc.ClientConn, error) {
+	opts := NewOptions(cfgs)
+	if err := LoadCredentials(cfgs, &opts); err != nil {
+		return nil, err
+	}
+	opts.DialOptions = dialOpts
+
+	return NewExporterGRPCClientPoolWithOptions(opts)
+}
+
+// NewExporterGRPCClientPoolWithOptions creates Options.ConnectionPoolSize gRPC client connections
+// as NewExporterGRPCClientPool, from explicit Options rather than configs.Configs.
+//
+// Parameters:
+//   - opts: The connection options
+//
+// Returns:
+//   - []*grpc.ClientConn: The configured gRPC client connections
+//   - error: Any error encountered during connection setup, in which case no connection is left open
+func NewExporterGRPCClientPoolWithOptions(opts Options) ([]*grpc.ClientConn, error) {
+	size := max(opts.ConnectionPoolSize, 1)
+
+	conns := make([]*grpc.ClientConn, 0, size)
+	for range size {
+		conn, err := NewExporterGRPCClientWithOptions(opts)
+		if err != nil {
+			for _, c := range conns {
+				_ = c.Close()
+			}
+			return nil, err
+		}
+
+		conns = append(conns, conn)
+	}
+
+	return conns, nil
+}
+
+// bufferOptions returns the read/write buffer size and flow-control window dial options.
+// Unset sizes keep the gRPC defaults.
+func bufferOptions(opts Options) []grpc.DialOption {
+	dialOpts := []grpc.DialOption{}
+
+	if opts.WriteBufferSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithWriteBufferSize(opts.WriteBufferSize))
+	}
+	if opts.ReadBufferSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithReadBufferSize(opts.ReadBufferSize))
+	}
+	if opts.InitialWindowSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithInitialWindowSize(opts.InitialWindowSize))
+	}
+	if opts.InitialConnWindowSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithInitialConnWindowSize(opts.InitialConnWindowSize))
+	}
+
+	return dialOpts
+}
+
+func evaluateCredentials(opts Options) credentials.TransportCredentials {
+	if !opts.TLSEnabled {
 		return insecure.NewCredentials()
 	}
 
-	certPool := x509.NewCertPool()
-	return credentials.NewClientTLSFromCert(certPool, "")
+	tlsConfig := &tls.Config{
+		RootCAs:              opts.RootCAs,
+		GetClientCertificate: opts.GetClientCertificate,
+	}
+	return withHandshakeTimeout(credentials.NewTLS(tlsConfig), opts.HandshakeTimeout)
+}
+
+// timeoutInterceptor bounds each unary call with the given timeout, keeping
+// the deadline of the call context when it is earlier.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
 }
 
+// ErrInsecureCredentials is returned when creating a connection that would send credentials
+// without transport security.
+var ErrInsecureCredentials = errors.New("otel exporter credentials require transport security")
+
 type perRPCCredentials struct {
-	tlsEnabled bool
-	headers    map[string]string
+	requireTransportSecurity bool
+	headers                  map[string]string
+	provider                 func(ctx context.Context) map[string]string
+	token                    func(ctx context.Context) (string, error)
 }
 
-func newPerRPCCredentials(cfgs *configs.Configs) credentials.PerRPCCredentials {
-	h := map[string]string{}
-
-	if cfgs.OTLPConfigs.ExporterHeaders != "" {
-		keyValue := strings.SplitSeq(cfgs.OTLPConfigs.ExporterHeaders, ",")
-		for kv := range keyValue {
-			parts := strings.SplitN(kv, "=", 2)
-			if len(parts) == 2 {
-				key := strings.TrimSpace(parts[0])
-				value := strings.TrimSpace(parts[1])
-				if key != "" {
-					h[key] = value
-				}
-			}
-		}
+// checkTransportSecurity rejects the options sending credentials over a plaintext connection:
+// a TokenProvider unless AllowInsecureHeaders is set, and Headers or a HeaderProvider when
+// RequireSecureHeaders is set. Plain headers are sent over plaintext connections by default.
+func checkTransportSecurity(opts Options) error {
+	if opts.TLSEnabled {
+		return nil
+	}
+
+	if opts.TokenProvider != nil && !opts.AllowInsecureHeaders {
+		return fmt.Errorf("%w: the bearer token requires TLS, enable it or allow insecure headers", ErrInsecureCredentials)
 	}
+	if opts.RequireSecureHeaders && (len(opts.Headers) > 0 || opts.HeaderProvider != nil) {
+		return fmt.Errorf("%w: secure headers are required, enable TLS", ErrInsecureCredentials)
+	}
+
+	return nil
+}
 
+func newPerRPCCredentials(opts Options) credentials.PerRPCCredentials {
+	// Transport security is required whenever TLS is enabled, and for bearer tokens, which
+	// always carry credentials. AllowInsecureHeaders explicitly lifts that requirement, e.g.
+	// in internal clusters where TLS is terminated by a mesh sidecar.
 	return &perRPCCredentials{
-		tlsEnabled: cfgs.OTLPConfigs.ExporterTLSEnabled,
-		headers:    h,
+		requireTransportSecurity: (opts.TLSEnabled || opts.TokenProvider != nil) && !opts.AllowInsecureHeaders,
+		headers:                  mergeHeaders(opts.ResourceHeaders, opts.Headers),
+		provider:                 opts.HeaderProvider,
+		token:                    opts.TokenProvider,
 	}
 }
 
-func (h *perRPCCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
-	return h.headers, nil
+// mergeHeaders returns the resource headers overridden by the explicit headers.
+func mergeHeaders(resourceHeaders, headers map[string]string) map[string]string {
+	if len(resourceHeaders) == 0 {
+		return headers
+	}
+
+	merged := maps.Clone(resourceHeaders)
+	maps.Copy(merged, headers)
+
+	return merged
+}
+
+func (h *perRPCCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	var dynamic map[string]string
+	if h.provider != nil {
+		dynamic = h.provider(ctx)
+	}
+
+	if len(dynamic) == 0 && h.token == nil {
+		return h.headers, nil
+	}
+
+	headers := make(map[string]string, len(h.headers)+len(dynamic)+1)
+	maps.Copy(headers, h.headers)
+	maps.Copy(headers, dynamic)
+
+	if h.token != nil {
+		token, err := h.token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the otel exporter token: %w", err)
+		}
+		headers["authorization"] = "Bearer " + token
+	}
+
+	return headers, nil
 }
 
 func (h *perRPCCredentials) RequireTransportSecurity() bool {
-	return h.tlsEnabled
+	return h.requireTransportSecurity
 }
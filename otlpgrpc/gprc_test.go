@@ -0,0 +1,89 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewExporterGRPCClientWithOptionsTransportSecurity(t *testing.T) {
+	headers := map[string]string{"x-api-key": "secret"}
+	token := func(context.Context) (string, error) { return "token", nil }
+
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{name: "plaintext with static headers", opts: Options{Headers: headers}},
+		{name: "plaintext with header provider", opts: Options{HeaderProvider: func(context.Context) map[string]string { return headers }}},
+		{name: "plaintext with token", opts: Options{TokenProvider: token}, wantErr: true},
+		{name: "plaintext with token allowed", opts: Options{TokenProvider: token, AllowInsecureHeaders: true}},
+		{name: "plaintext with required secure headers", opts: Options{Headers: headers, RequireSecureHeaders: true}, wantErr: true},
+		{name: "plaintext without headers and required secure headers", opts: Options{RequireSecureHeaders: true}},
+		{name: "plaintext with resource headers and required secure headers", opts: Options{ResourceHeaders: headers, RequireSecureHeaders: true}},
+		{name: "TLS with required secure headers", opts: Options{Headers: headers, RequireSecureHeaders: true, TLSEnabled: true}},
+		{name: "TLS with token", opts: Options{TokenProvider: token, TLSEnabled: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Endpoint = "localhost:4317"
+
+			conn, err := NewExporterGRPCClientWithOptions(tt.opts)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInsecureCredentials) {
+					t.Errorf("NewExporterGRPCClientWithOptions() error = %v, want ErrInsecureCredentials", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewExporterGRPCClientWithOptions() error = %v, want nil", err)
+			}
+			_ = conn.Close()
+		})
+	}
+}
+
+func TestPerRPCCredentialsRequireTransportSecurity(t *testing.T) {
+	token := func(context.Context) (string, error) { return "token", nil }
+
+	tests := []struct {
+		name string
+		opts Options
+		want bool
+	}{
+		{name: "plaintext with headers", opts: Options{Headers: map[string]string{"k": "v"}}, want: false},
+		{name: "TLS", opts: Options{TLSEnabled: true}, want: true},
+		{name: "TLS with insecure headers allowed", opts: Options{TLSEnabled: true, AllowInsecureHeaders: true}, want: false},
+		{name: "token", opts: Options{TokenProvider: token}, want: true},
+		{name: "token with insecure headers allowed", opts: Options{TokenProvider: token, AllowInsecureHeaders: true}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newPerRPCCredentials(tt.opts).RequireTransportSecurity(); got != tt.want {
+				t.Errorf("RequireTransportSecurity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPerRPCCredentialsResourceHeaders(t *testing.T) {
+	creds := newPerRPCCredentials(Options{
+		ResourceHeaders: map[string]string{"service-name": "checkout", "tenant": "resource"},
+		Headers:         map[string]string{"tenant": "explicit"},
+	})
+
+	md, err := creds.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata() error = %v, want nil", err)
+	}
+	if md["service-name"] != "checkout" || md["tenant"] != "explicit" {
+		t.Errorf("GetRequestMetadata() = %v, want service-name=checkout and the explicit tenant", md)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// DefaultHandshakeTimeout bounds the TLS handshake when Options.HandshakeTimeout is not set.
+const DefaultHandshakeTimeout = 10 * time.Second
+
+// handshakeTimeoutCredentials bounds the client handshake of the wrapped credentials, so that
+// a collector reachable over TCP but stalling the TLS handshake (e.g. behind a misconfigured
+// proxy) fails the connection attempt instead of hanging it.
+type handshakeTimeoutCredentials struct {
+	credentials.TransportCredentials
+	timeout time.Duration
+}
+
+func withHandshakeTimeout(creds credentials.TransportCredentials, timeout time.Duration) credentials.TransportCredentials {
+	if timeout <= 0 {
+		timeout = DefaultHandshakeTimeout
+	}
+
+	return &handshakeTimeoutCredentials{TransportCredentials: creds, timeout: timeout}
+}
+
+func (c *handshakeTimeoutCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	secured, info, err := c.TransportCredentials.ClientHandshake(ctx, authority, conn)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, nil, fmt.Errorf("otel exporter TLS handshake timed out after %s: %w", c.timeout, err)
+	}
+
+	return secured, info, err
+}
+
+func (c *handshakeTimeoutCredentials) Clone() credentials.TransportCredentials {
+	return &handshakeTimeoutCredentials{TransportCredentials: c.TransportCredentials.Clone(), timeout: c.timeout}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc/stats"
+)
+
+// ConnectionMonitor counts the reconnections of the gRPC client connections created with it
+// as Options.Monitor, which helps diagnosing flapping collectors, and the bytes they sent.
+type ConnectionMonitor struct {
+	reconnections atomic.Uint64
+	bytesSent     atomic.Uint64
+}
+
+// NewConnectionMonitor creates a ConnectionMonitor, to be set as Options.Monitor of the
+// connections to be monitored.
+//
+// Returns:
+//   - *ConnectionMonitor: The connection monitor
+func NewConnectionMonitor() *ConnectionMonitor {
+	return &ConnectionMonitor{}
+}
+
+// Reconnections returns the number of transports established by the monitored connections
+// after their first one, i.e. after the collector was lost, restarted or the connection idled.
+//
+// gRPC resets the reconnection backoff as soon as a transport is established, so that
+// reconnection attempts start again from the base delay after an outage.
+func (m *ConnectionMonitor) Reconnections() uint64 {
+	return m.reconnections.Load()
+}
+
+// BytesSent returns the number of bytes the monitored connections sent on the wire in export
+// calls, after compression and excluding the gRPC and HTTP/2 framing.
+func (m *ConnectionMonitor) BytesSent() uint64 {
+	return m.bytesSent.Load()
+}
+
+// handler returns the stats.Handler reporting the transports of a single connection.
+func (m *ConnectionMonitor) handler() stats.Handler {
+	return &connectionStats{monitor: m}
+}
+
+type connectionStats struct {
+	monitor   *ConnectionMonitor
+	connected atomic.Bool
+}
+
+func (s *connectionStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (s *connectionStats) HandleConn(_ context.Context, cs stats.ConnStats) {
+	if _, ok := cs.(*stats.ConnBegin); !ok {
+		return
+	}
+
+	if s.connected.Swap(true) {
+		s.monitor.reconnections.Add(1)
+	}
+}
+
+func (s *connectionStats) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (s *connectionStats) HandleRPC(_ context.Context, rs stats.RPCStats) {
+	if out, ok := rs.(*stats.OutPayload); ok && out.IsClient() {
+		s.monitor.bytesSent.Add(uint64(out.WireLength))
+	}
+}
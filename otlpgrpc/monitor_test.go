@@ -0,0 +1,156 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Reconnection backoff of the tests: failed attempts are retried after 20ms, 100ms, 500ms,
+// 2.5s, ... (±20% jitter), so that a backoff accumulated over a few failed attempts is
+// clearly longer than the base delay.
+var testBackoff = backoff.Config{
+	BaseDelay:  20 * time.Millisecond,
+	Multiplier: 5,
+	Jitter:     0.2,
+	MaxDelay:   time.Minute,
+}
+
+// restartableCollector is an in-memory gRPC server that can be stopped and restarted,
+// counting the connection attempts dialed while it is stopped.
+type restartableCollector struct {
+	mu       sync.Mutex
+	listener *bufconn.Listener
+	server   *grpc.Server
+	failures atomic.Int64
+}
+
+func (c *restartableCollector) start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.listener = bufconn.Listen(1 << 20)
+	c.server = grpc.NewServer()
+	go func(server *grpc.Server, listener net.Listener) {
+		_ = server.Serve(listener)
+	}(c.server, c.listener)
+}
+
+func (c *restartableCollector) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.server.Stop()
+	c.listener = nil
+}
+
+func (c *restartableCollector) dial(ctx context.Context, _ string) (net.Conn, error) {
+	c.mu.Lock()
+	listener := c.listener
+	c.mu.Unlock()
+
+	if listener == nil {
+		c.failures.Add(1)
+		return nil, errors.New("collector stopped")
+	}
+	return listener.DialContext(ctx)
+}
+
+// waitFailures keeps conn connecting, as export calls would, until at least n connection
+// attempts failed.
+func (c *restartableCollector) waitFailures(t *testing.T, conn *grpc.ClientConn, n int64) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for c.failures.Load() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("failed connection attempts = %d, want %d", c.failures.Load(), n)
+		}
+		conn.Connect()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// waitReady connects conn and waits until it is ready, returning how long it took.
+func waitReady(t *testing.T, conn *grpc.ClientConn) time.Duration {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	began := time.Now()
+	for {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return time.Since(began)
+		case connectivity.Idle:
+			conn.Connect()
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			t.Fatalf("connection state = %v, want %v", state, connectivity.Ready)
+		}
+	}
+}
+
+func TestConnectionMonitorResetsBackoffAfterReconnection(t *testing.T) {
+	collector := &restartableCollector{}
+	collector.start()
+	defer collector.stop()
+
+	monitor := NewConnectionMonitor()
+	conn, err := NewExporterGRPCClientWithOptions(Options{
+		Endpoint: "passthrough:///bufnet",
+		Monitor:  monitor,
+		DialOptions: []grpc.DialOption{
+			grpc.WithContextDialer(collector.dial),
+			grpc.WithConnectParams(grpc.ConnectParams{Backoff: testBackoff}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewExporterGRPCClientWithOptions() error = %v, want nil", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	waitReady(t, conn)
+	if got := monitor.Reconnections(); got != 0 {
+		t.Fatalf("Reconnections() after the first connection = %d, want 0", got)
+	}
+
+	// A first outage long enough for the backoff to grow past 2s.
+	collector.stop()
+	collector.waitFailures(t, conn, 4)
+	collector.start()
+
+	waitReady(t, conn)
+	if got := monitor.Reconnections(); got != 1 {
+		t.Fatalf("Reconnections() after the first outage = %d, want 1", got)
+	}
+
+	// A second outage: had the backoff not been reset by the reconnection, the attempt
+	// following the first failure would wait for the accumulated delay of at least 2s.
+	failures := collector.failures.Load()
+	collector.stop()
+	collector.waitFailures(t, conn, failures+1)
+	collector.start()
+
+	if elapsed := waitReady(t, conn); elapsed > time.Second {
+		t.Errorf("reconnection after the second outage took %v, want it within the base backoff delays", elapsed)
+	}
+	if got := monitor.Reconnections(); got != 2 {
+		t.Errorf("Reconnections() after the second outage = %d, want 2", got)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlpgrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goxkit/configs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+// Options holds the settings used to create the gRPC client connections to an OTLP collector.
+// It decouples the transport from the configs package, so that connections can be created
+// by applications not relying on configs.Configs.
+type Options struct {
+	// Endpoint is the address of the OTLP collector, e.g. "localhost:4317". URLs such as
+	// "https://collector:4317" are accepted, their scheme being stripped (see NormalizeEndpoint).
+	Endpoint string
+	// TLSEnabled enables TLS on the connection.
+	TLSEnabled bool
+	// RootCAs are the certificate authorities used to verify the collector when TLS is enabled,
+	// the system roots being used when nil.
+	RootCAs *x509.CertPool
+	// HandshakeTimeout bounds the TLS handshake when TLS is enabled, independently of the
+	// connection attempt, DefaultHandshakeTimeout is used when zero.
+	HandshakeTimeout time.Duration
+	// GetClientCertificate, when set, returns the certificate presented to the collector for
+	// mutual TLS when TLS is enabled. It is called on each TLS handshake, so a rotated certificate
+	// is used from the next reconnection (see FileClientCertificate).
+	GetClientCertificate func(*tls.CertificateRequestInfo) (*tls.Certificate, error)
+	// TokenProvider, when set, returns the bearer token, e.g. a short-lived JWT, sent in the
+	// authorization header of every export call, overriding Headers and HeaderProvider. It is
+	// called on every call, so it should cache the token while it is valid (see FileToken).
+	// A failing provider fails the call. Combined with GetClientCertificate, every export is
+	// authenticated by both the client certificate and the token.
+	TokenProvider func(ctx context.Context) (string, error)
+	// Headers are sent as metadata with every export call.
+	Headers map[string]string
+	// ResourceHeaders are non-secret headers sent with every export call, e.g. mirroring resource
+	// attributes for collectors routing on metadata. Headers take precedence over them, and unlike
+	// Headers, they are never subject to RequireSecureHeaders.
+	ResourceHeaders map[string]string
+	// AllowInsecureHeaders allows TokenProvider to be used without transport security, which
+	// a bearer token requires by default.
+	AllowInsecureHeaders bool
+	// RequireSecureHeaders refuses to send Headers and HeaderProvider headers without transport
+	// security, creating the connection failing when TLS is disabled. Plaintext is allowed by default.
+	RequireSecureHeaders bool
+	// IdleTimeout is the duration after which an idle connection is closed.
+	IdleTimeout time.Duration
+	// KeepAliveTime is the interval between keepalive pings.
+	KeepAliveTime time.Duration
+	// KeepAliveTimeout is the time to wait for a keepalive ack.
+	KeepAliveTimeout time.Duration
+	// WriteBufferSize is the gRPC write buffer size in bytes, the gRPC default is used when zero.
+	WriteBufferSize int
+	// ReadBufferSize is the gRPC read buffer size in bytes, the gRPC default is used when zero.
+	ReadBufferSize int
+	// InitialWindowSize and InitialConnWindowSize are the HTTP/2 flow-control windows of each
+	// call and of the whole connection in bytes, to be raised on high-bandwidth, high-latency links
+	// where the defaults throttle throughput. Setting either disables the gRPC dynamic window
+	// sizing based on the estimated bandwidth-delay product, values below 64KiB are ignored by gRPC,
+	// and the gRPC defaults are used when zero.
+	InitialWindowSize     int32
+	InitialConnWindowSize int32
+	// ConnectionPoolSize is the number of connections created by NewExporterGRPCClientPoolWithOptions.
+	ConnectionPoolSize int
+	// Compression is the compression of export calls, either CompressionGzip or "none" (the default).
+	Compression string
+	// TracesCompression, MetricsCompression and LogsCompression override Compression for the
+	// export calls of their signal, so that e.g. large span batches are compressed while small
+	// metric exports spare the CPU. Compression applies when they are empty.
+	TracesCompression  string
+	MetricsCompression string
+	LogsCompression    string
+	// CompressionLevel is the gzip compression level, from 1 (fastest) to 9 (smallest),
+	// the gzip default level is used when zero. The level replaces the gzip compressor of the
+	// whole process, and exporters asking for different levels fail.
+	CompressionLevel int
+	// Timeout bounds each export call made over the connection, no timeout is added when zero.
+	Timeout time.Duration
+	// WaitForReady makes export calls wait for the connection to be ready, up to their deadline,
+	// instead of failing fast while it is reconnecting.
+	WaitForReady bool
+	// Monitor, when set, counts the reconnections of the connections.
+	Monitor *ConnectionMonitor
+	// HeaderProvider, when set, returns headers evaluated on each export call from its context,
+	// sent in addition to Headers and overriding them. Headers are per call, not per span: a
+	// call exports a whole batch, which can be given a context with exporter.NewBatchContext.
+	HeaderProvider func(ctx context.Context) map[string]string
+	// DialOptions are applied after the options derived from the other fields, which they
+	// override, e.g. grpc.WithContextDialer to dial an in-process bufconn listener in tests.
+	DialOptions []grpc.DialOption
+	// Resolvers are name resolvers available to the connections only, in addition to the ones
+	// registered globally with resolver.Register, so that an Endpoint such as
+	// "consul://service/otel-collector" is resolved through service discovery. Endpoints without
+	// a scheme keep being resolved through DNS.
+	Resolvers []resolver.Builder
+}
+
+// NewOptions translates the OTLP settings of the application configurations into Options.
+//
+// An Endpoint written as a URL is normalized with NormalizeEndpoint, its scheme deciding whether
+// TLS is enabled. A warning is logged when the scheme conflicts with ExporterTLSEnabled.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - Options: The equivalent connection options
+func NewOptions(cfgs *configs.Configs) Options {
+	endpoint, tls := normalizeConfiguredEndpoint(cfgs)
+
+	return Options{
+		Endpoint:              endpoint,
+		TLSEnabled:            tls,
+		HandshakeTimeout:      cfgs.OTLPConfigs.ExporterHandshakeTimeout,
+		Headers:               ParseHeaders(cfgs.OTLPConfigs.ExporterHeaders),
+		AllowInsecureHeaders:  cfgs.OTLPConfigs.AllowInsecureHeaders,
+		RequireSecureHeaders:  cfgs.OTLPConfigs.RequireSecureHeaders,
+		IdleTimeout:           cfgs.OTLPConfigs.ExporterIdleTimeout,
+		KeepAliveTime:         cfgs.OTLPConfigs.ExporterKeepAliveTime,
+		KeepAliveTimeout:      cfgs.OTLPConfigs.ExporterKeepAliveTimeout,
+		WriteBufferSize:       cfgs.OTLPConfigs.ExporterWriteBufferSize,
+		ReadBufferSize:        cfgs.OTLPConfigs.ExporterReadBufferSize,
+		InitialWindowSize:     cfgs.OTLPConfigs.ExporterInitialWindowSize,
+		InitialConnWindowSize: cfgs.OTLPConfigs.ExporterInitialConnWindowSize,
+		ConnectionPoolSize:    cfgs.OTLPConfigs.ExporterConnectionPoolSize,
+		Compression:           cfgs.OTLPConfigs.ExporterCompression,
+		TracesCompression:     cfgs.OTLPConfigs.TracesCompression,
+		MetricsCompression:    cfgs.OTLPConfigs.MetricsCompression,
+		LogsCompression:       cfgs.OTLPConfigs.LogsCompression,
+		CompressionLevel:      cfgs.OTLPConfigs.ExporterCompressionLevel,
+		WaitForReady:          cfgs.OTLPConfigs.ExporterWaitForReady,
+	}
+}
+
+func normalizeConfiguredEndpoint(cfgs *configs.Configs) (string, bool) {
+	endpoint, tls, implied := NormalizeEndpoint(cfgs.OTLPConfigs.Endpoint)
+	if !implied {
+		return endpoint, cfgs.OTLPConfigs.ExporterTLSEnabled
+	}
+
+	if tls != cfgs.OTLPConfigs.ExporterTLSEnabled && cfgs.Logger != nil {
+		cfgs.Logger.Warn(
+			"otel exporter endpoint scheme conflicts with ExporterTLSEnabled, using the scheme",
+			zap.String("endpoint", cfgs.OTLPConfigs.Endpoint),
+			zap.Bool("tls_enabled", tls),
+		)
+	}
+
+	return endpoint, tls
+}
+
+// ParseHeaders parses a comma-separated list of key=value pairs, as used by the
+// OTEL_EXPORTER_OTLP_HEADERS environment variable. Keys and values are percent-decoded, as the
+// specification requires (e.g. "Authorization=Bearer%20token"). Malformed pairs, empty keys and
+// pairs that fail to decode are ignored.
+//
+// Parameters:
+//   - headers: The comma-separated key=value pairs
+//
+// Returns:
+//   - map[string]string: The parsed headers
+func ParseHeaders(headers string) map[string]string {
+	h := map[string]string{}
+
+	if headers != "" {
+		keyValue := strings.SplitSeq(headers, ",")
+		for kv := range keyValue {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+
+			key, keyErr := url.PathUnescape(strings.TrimSpace(parts[0]))
+			value, valueErr := url.PathUnescape(strings.TrimSpace(parts[1]))
+			if keyErr == nil && valueErr == nil && key != "" {
+				h[key] = value
+			}
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,413 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Default batching settings, matching the OpenTelemetry SDK batch span processor.
+const (
+	DefaultMaxQueueSize       = 2048
+	DefaultMaxExportBatchSize = 512
+	DefaultBatchTimeout       = 5 * time.Second
+	DefaultExportTimeout      = 30 * time.Second
+)
+
+// ErrPaused is returned by ForceFlush while exporting is paused, the queued spans not being exported.
+var ErrPaused = errors.New("span export is paused")
+
+// Behaviors of a Batch processor whose queue is full, see BatchOptions.OnQueueFull.
+const (
+	// QueueFullDrop drops the spans ending while the queue is full.
+	QueueFullDrop = "drop"
+	// QueueFullBlock blocks the callers ending spans until the queue has room.
+	QueueFullBlock = "block"
+)
+
+// BatchOptions configures a Batch processor. Zero values select the defaults.
+type BatchOptions struct {
+	// MaxQueueSize is the maximum number of spans waiting to be exported. Spans ending
+	// while the queue is full are dropped, unless OnQueueFull is QueueFullBlock.
+	MaxQueueSize int
+	// OnQueueFull is QueueFullDrop, the default, or QueueFullBlock to block span end until the
+	// queue has room, trading latency for completeness.
+	OnQueueFull string
+	// MaxBlockTime bounds how long a span end blocks on a full queue before the span is dropped.
+	// A non-positive duration blocks until the queue has room or the processor is shut down.
+	MaxBlockTime time.Duration
+	// MaxExportBatchSize is the maximum number of spans sent in a single export call.
+	MaxExportBatchSize int
+	// ExportOnCount, when positive, makes the count the primary export trigger: spans are
+	// exported in batches of exactly ExportOnCount spans as soon as they accumulate, overriding
+	// MaxExportBatchSize, and BatchTimeout only bounds how long a span waits in a partial batch.
+	ExportOnCount int
+	// BatchTimeout is the maximum delay before queued spans are exported.
+	BatchTimeout time.Duration
+	// ExportTimeout bounds a single export call.
+	ExportTimeout time.Duration
+	// Clock schedules the batch timeout, clock.Real is used when nil.
+	Clock clock.Clock
+	// ProfilingLabels labels the export loop goroutine, and the export goroutines it starts,
+	// with the "span_batch_processor" ProfilingComponentLabel pprof label.
+	ProfilingLabels bool
+}
+
+// Batch is a batching SpanProcessor equivalent to the OpenTelemetry SDK batch span processor,
+// whose queue is observable: QueueLen and Dropped expose its current depth and the number of
+// spans dropped because it was full, e.g. to alert on backpressure before spans are lost.
+type Batch struct {
+	exporter sdktrace.SpanExporter
+	opts     BatchOptions
+
+	mu sync.Mutex
+	// stopped is set by Shutdown, under mu so that no span is queued after the final export.
+	stopped bool
+	queue   []sdktrace.ReadOnlySpan
+	// queuedAt holds the time each queued span was queued at, in ExportOnCount mode only.
+	queuedAt []time.Time
+	// freed is closed, and replaced, whenever spans leave the queue, waking the blocked span ends.
+	freed chan struct{}
+
+	dropped atomic.Uint64
+	paused  atomic.Bool
+
+	batchReady chan struct{}
+	flushReq   chan chan error
+	stop       chan struct{}
+	done       chan struct{}
+	stopOnce   sync.Once
+
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+// NewBatch creates a Batch processor exporting spans to the given exporter and starts its export loop.
+//
+// Parameters:
+//   - exporter: The exporter spans are sent to
+//   - opts: The batching options
+//
+// Returns:
+//   - *Batch: The batch processor
+func NewBatch(exporter sdktrace.SpanExporter, opts BatchOptions) *Batch {
+	if opts.MaxQueueSize <= 0 {
+		opts.MaxQueueSize = DefaultMaxQueueSize
+	}
+	if opts.ExportOnCount > 0 {
+		opts.MaxExportBatchSize = opts.ExportOnCount
+	}
+	if opts.MaxExportBatchSize <= 0 {
+		opts.MaxExportBatchSize = DefaultMaxExportBatchSize
+	}
+	opts.MaxExportBatchSize = min(opts.MaxExportBatchSize, opts.MaxQueueSize)
+	if opts.BatchTimeout <= 0 {
+		opts.BatchTimeout = DefaultBatchTimeout
+	}
+	if opts.ExportTimeout <= 0 {
+		opts.ExportTimeout = DefaultExportTimeout
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.Real()
+	}
+
+	b := &Batch{
+		exporter:   exporter,
+		opts:       opts,
+		queue:      make([]sdktrace.ReadOnlySpan, 0, opts.MaxExportBatchSize),
+		freed:      make(chan struct{}),
+		batchReady: make(chan struct{}, 1),
+		flushReq:   make(chan chan error),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+
+	start(opts.ProfilingLabels, "span_batch_processor", b.run)
+
+	return b
+}
+
+// QueueLen returns the number of spans waiting to be exported.
+func (b *Batch) QueueLen() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.queue)
+}
+
+// QueueCapacity returns the maximum number of spans waiting to be exported.
+func (b *Batch) QueueCapacity() int {
+	return b.opts.MaxQueueSize
+}
+
+// Dropped returns the number of spans dropped because the queue was full, including the ones
+// whose span end blocked for longer than MaxBlockTime.
+func (b *Batch) Dropped() uint64 {
+	return b.dropped.Load()
+}
+
+// Pause stops exporting spans, e.g. during a collector maintenance window. Spans keep being
+// queued within MaxQueueSize, and the ones ending while the queue is full are dropped and
+// counted by Dropped, even with the QueueFullBlock behavior. ForceFlush doesn't export while
+// paused and returns ErrPaused, Shutdown still does.
+func (b *Batch) Pause() {
+	b.paused.Store(true)
+}
+
+// Resume resumes exporting spans after Pause, exporting the queued spans right away.
+func (b *Batch) Resume() {
+	if !b.paused.Swap(false) {
+		return
+	}
+
+	go func() {
+		_ = b.ForceFlush(context.Background())
+	}()
+}
+
+// Paused reports whether exporting is paused.
+func (b *Batch) Paused() bool {
+	return b.paused.Load()
+}
+
+// OnStart does nothing.
+func (b *Batch) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd queues sampled spans for export. When the queue is full, spans are dropped or, with
+// the QueueFullBlock behavior, OnEnd blocks until the queue has room. Spans ending once Shutdown
+// is called are ignored, except the ones blocked on a full queue, which are dropped.
+func (b *Batch) OnEnd(s sdktrace.ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() {
+		return
+	}
+
+	var deadline <-chan time.Time
+	b.mu.Lock()
+	if b.stopped {
+		b.mu.Unlock()
+		return
+	}
+	for len(b.queue) >= b.opts.MaxQueueSize {
+		freed := b.freed
+		b.mu.Unlock()
+
+		if b.opts.OnQueueFull != QueueFullBlock || b.paused.Load() {
+			b.dropped.Add(1)
+			return
+		}
+
+		if deadline == nil && b.opts.MaxBlockTime > 0 {
+			timer := b.opts.Clock.NewTimer(b.opts.MaxBlockTime)
+			defer timer.Stop()
+			deadline = timer.Chan()
+		}
+
+		select {
+		case b.batchReady <- struct{}{}:
+		default:
+		}
+
+		select {
+		case <-freed:
+		case <-deadline:
+			b.dropped.Add(1)
+			return
+		case <-b.stop:
+			b.dropped.Add(1)
+			return
+		}
+
+		b.mu.Lock()
+		if b.stopped {
+			b.mu.Unlock()
+			b.dropped.Add(1)
+			return
+		}
+	}
+	b.queue = append(b.queue, s)
+	if b.opts.ExportOnCount > 0 {
+		b.queuedAt = append(b.queuedAt, b.opts.Clock.Now())
+	}
+	ready := len(b.queue) >= b.opts.MaxExportBatchSize
+	b.mu.Unlock()
+
+	if ready {
+		select {
+		case b.batchReady <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ForceFlush exports every queued span, returning once the export calls are done
+// or when ctx is done. It returns ErrPaused without exporting while exporting is paused.
+func (b *Batch) ForceFlush(ctx context.Context) error {
+	flushed := make(chan error, 1)
+	select {
+	case b.flushReq <- flushed:
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-flushed:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown stops accepting spans, exports the queued ones and shuts the exporter down.
+// It returns when done or when ctx is done, in which case the drain goes on in background
+// and a later call waits for it again before shutting the exporter down.
+func (b *Batch) Shutdown(ctx context.Context) error {
+	b.stopOnce.Do(func() {
+		b.mu.Lock()
+		b.stopped = true
+		b.mu.Unlock()
+		close(b.stop)
+	})
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	b.shutdownOnce.Do(func() {
+		b.shutdownErr = b.exporter.Shutdown(ctx)
+	})
+
+	return b.shutdownErr
+}
+
+func (b *Batch) run() {
+	defer close(b.done)
+
+	timer := b.opts.Clock.NewTimer(b.opts.BatchTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.Chan():
+			switch {
+			case b.paused.Load():
+			case b.opts.ExportOnCount > 0:
+				b.exportWaiting()
+			default:
+				b.exportAll()
+			}
+			timer.Reset(b.nextTimeout())
+		case <-b.batchReady:
+			switch {
+			case b.paused.Load():
+			case b.opts.ExportOnCount > 0:
+				b.exportFull()
+			default:
+				b.exportBatch()
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.Chan():
+				default:
+				}
+			}
+			timer.Reset(b.nextTimeout())
+		case flushed := <-b.flushReq:
+			if b.paused.Load() {
+				flushed <- ErrPaused
+				continue
+			}
+			b.exportAll()
+			flushed <- nil
+		case <-b.stop:
+			b.exportAll()
+			return
+		}
+	}
+}
+
+// exportAll exports the queued spans in batches until the queue is empty.
+func (b *Batch) exportAll() {
+	for b.exportBatch() {
+	}
+}
+
+// exportFull exports the queued spans in batches of exactly ExportOnCount spans while enough are queued.
+func (b *Batch) exportFull() {
+	for b.QueueLen() >= b.opts.MaxExportBatchSize {
+		b.exportBatch()
+	}
+}
+
+// exportWaiting exports the queued spans in batches while the oldest one waited for BatchTimeout,
+// in ExportOnCount mode.
+func (b *Batch) exportWaiting() {
+	for {
+		b.mu.Lock()
+		waiting := len(b.queuedAt) > 0 && b.opts.Clock.Since(b.queuedAt[0]) >= b.opts.BatchTimeout
+		b.mu.Unlock()
+
+		if !waiting || !b.exportBatch() {
+			return
+		}
+	}
+}
+
+// nextTimeout returns the delay before the next timeout export: BatchTimeout, or in ExportOnCount
+// mode, the time left before the oldest queued span waited for BatchTimeout.
+func (b *Batch) nextTimeout() time.Duration {
+	if b.opts.ExportOnCount <= 0 || b.paused.Load() {
+		return b.opts.BatchTimeout
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.queuedAt) == 0 {
+		return b.opts.BatchTimeout
+	}
+
+	return max(b.opts.BatchTimeout-b.opts.Clock.Since(b.queuedAt[0]), time.Millisecond)
+}
+
+// exportBatch exports up to MaxExportBatchSize queued spans, reporting whether spans
+// were exported. Export errors are reported to the global OpenTelemetry error handler.
+func (b *Batch) exportBatch() bool {
+	b.mu.Lock()
+	n := min(len(b.queue), b.opts.MaxExportBatchSize)
+	if n == 0 {
+		b.mu.Unlock()
+		return false
+	}
+	batch := make([]sdktrace.ReadOnlySpan, n)
+	copy(batch, b.queue)
+	b.queue = append(b.queue[:0], b.queue[n:]...)
+	if b.opts.ExportOnCount > 0 {
+		b.queuedAt = append(b.queuedAt[:0], b.queuedAt[n:]...)
+	}
+	close(b.freed)
+	b.freed = make(chan struct{})
+	b.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.opts.ExportTimeout)
+	defer cancel()
+
+	if err := b.exporter.ExportSpans(ctx, batch); err != nil {
+		otel.Handle(err)
+	}
+
+	return true
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	"github.com/goxkit/otel/exporter"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestBatchExportsAfterBatchTimeout(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	exp := tracetest.NewInMemoryExporter()
+	batch := NewBatch(exp, BatchOptions{BatchTimeout: time.Second, Clock: clk})
+	defer func() { _ = batch.Shutdown(context.Background()) }()
+
+	batch.OnEnd(tracetest.SpanStub{
+		Name: "timeout",
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: trace.FlagsSampled,
+		}),
+	}.Snapshot())
+
+	clk.Advance(999 * time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	if got := len(exp.GetSpans()); got != 0 {
+		t.Fatalf("exported spans before the batch timeout = %d, want 0", got)
+	}
+
+	// The run loop creates its timer asynchronously, so the clock is advanced again
+	// until the timeout export is observed.
+	deadline := time.Now().Add(5 * time.Second)
+	for len(exp.GetSpans()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("span was not exported after the batch timeout")
+		}
+		clk.Advance(time.Second)
+		time.Sleep(time.Millisecond)
+	}
+
+	if got := exp.GetSpans(); len(got) != 1 || got[0].Name != "timeout" {
+		t.Errorf("exported spans = %v, want the timeout span", got)
+	}
+}
+
+func BenchmarkBatchOnEndParallel(b *testing.B) {
+	span := tracetest.SpanStub{
+		Name: "bench",
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: trace.FlagsSampled,
+		}),
+	}.Snapshot()
+
+	for _, onQueueFull := range []string{QueueFullDrop, QueueFullBlock} {
+		b.Run(onQueueFull, func(b *testing.B) {
+			batch := NewBatch(exporter.NewDiscard(), BatchOptions{OnQueueFull: onQueueFull})
+			defer func() { _ = batch.Shutdown(context.Background()) }()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					batch.OnEnd(span)
+				}
+			})
+
+			b.StopTimer()
+			b.ReportMetric(float64(batch.Dropped())/float64(b.N), "dropped/op")
+		})
+	}
+}
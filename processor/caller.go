@@ -0,0 +1,83 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"runtime"
+	"strings"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// callerMaxDepth bounds the number of stack frames inspected to find the caller starting a span.
+const callerMaxDepth = 32
+
+// callerSkippedModules are the modules whose frames are skipped when looking for the caller,
+// as they start spans on behalf of the application.
+var callerSkippedModules = []string{
+	"go.opentelemetry.io/otel",
+	"github.com/goxkit/otel",
+}
+
+type callerInfo struct {
+	wrapper
+}
+
+// NewCallerInfo creates a SpanProcessor attaching the source code location starting each span, as
+// the code.filepath, code.lineno and code.function attributes, before handing it to the next processor.
+// The location is the first stack frame outside of the OpenTelemetry SDK and of this module, which
+// pinpoints where a span originated in large codebases.
+//
+// Walking the stack on every span start has a cost, so it's meant to be enabled for debugging.
+//
+// Parameters:
+//   - next: The processor spans are handed to
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The caller info processor
+func NewCallerInfo(next sdktrace.SpanProcessor) sdktrace.SpanProcessor {
+	return &callerInfo{wrapper: wrapper{next: next}}
+}
+
+func (p *callerInfo) OnStart(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	if frame, ok := caller(); ok {
+		s.SetAttributes(
+			semconv.CodeFilepath(frame.File),
+			semconv.CodeLineNumber(frame.Line),
+			semconv.CodeFunction(frame.Function),
+		)
+	}
+
+	p.next.OnStart(ctx, s)
+}
+
+// caller returns the first stack frame outside of the skipped modules.
+func caller() (runtime.Frame, bool) {
+	pcs := make([]uintptr, callerMaxDepth)
+	n := runtime.Callers(3, pcs)
+
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !skippedFrame(frame.Function) {
+			return frame, true
+		}
+		if !more {
+			return runtime.Frame{}, false
+		}
+	}
+}
+
+func skippedFrame(function string) bool {
+	for _, module := range callerSkippedModules {
+		if rest, ok := strings.CutPrefix(function, module); ok && (rest == "" || rest[0] == '/' || rest[0] == '.') {
+			return true
+		}
+	}
+
+	return false
+}
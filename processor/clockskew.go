@@ -0,0 +1,93 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ClockSkewOptions configures a ClockSkew processor.
+type ClockSkewOptions struct {
+	// MaxSkew is how far in the future span timestamps may be before they are clamped to the
+	// current time.
+	MaxSkew time.Duration
+	// Clock provides the current time, clock.Real is used when nil.
+	Clock clock.Clock
+	// OnClamp, when set, is called with every clamped span, before its timestamps are clamped,
+	// e.g. to log it.
+	OnClamp func(s sdktrace.ReadOnlySpan)
+}
+
+type clockSkew struct {
+	wrapper
+	opts ClockSkewOptions
+}
+
+// NewClockSkew creates a SpanProcessor clamping the timestamps of the ended spans to sane bounds
+// before handing them to the next processor, salvaging the telemetry of hosts with drifting
+// clocks that backends would otherwise reject: start and end timestamps more than MaxSkew in the
+// future are set to the current time, then an end timestamp before the start is set to the start.
+//
+// Parameters:
+//   - next: The processor receiving the spans
+//   - opts: The clamping options, a non-positive MaxSkew disabling the processor
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The clamping processor
+func NewClockSkew(next sdktrace.SpanProcessor, opts ClockSkewOptions) sdktrace.SpanProcessor {
+	if opts.MaxSkew <= 0 {
+		return next
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.Real()
+	}
+
+	return &clockSkew{wrapper: wrapper{next: next}, opts: opts}
+}
+
+func (p *clockSkew) OnEnd(s sdktrace.ReadOnlySpan) {
+	now := p.opts.Clock.Now()
+	limit := now.Add(p.opts.MaxSkew)
+
+	start, end := s.StartTime(), s.EndTime()
+	if start.After(limit) {
+		start = now
+	}
+	if end.After(limit) {
+		end = now
+	}
+	if end.Before(start) {
+		end = start
+	}
+
+	if start.Equal(s.StartTime()) && end.Equal(s.EndTime()) {
+		p.next.OnEnd(s)
+		return
+	}
+
+	if p.opts.OnClamp != nil {
+		p.opts.OnClamp(s)
+	}
+
+	p.next.OnEnd(&clampedSpan{ReadOnlySpan: s, start: start, end: end})
+}
+
+// clampedSpan overrides the timestamps of an ended span.
+type clampedSpan struct {
+	sdktrace.ReadOnlySpan
+	start time.Time
+	end   time.Time
+}
+
+func (s *clampedSpan) StartTime() time.Time {
+	return s.start
+}
+
+func (s *clampedSpan) EndTime() time.Time {
+	return s.end
+}
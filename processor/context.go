@@ -0,0 +1,41 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type contextAttribute struct {
+	wrapper
+	key   attribute.Key
+	value func(context.Context) string
+}
+
+// NewContextAttribute creates a SpanProcessor setting the key attribute on each span to the value
+// read from the context the span is started with, e.g. the tenant of a request, before handing it
+// to the next processor. Spans started without a value are left untouched.
+//
+// Parameters:
+//   - next: The processor spans are handed to
+//   - key: The attribute key
+//   - value: Returns the attribute value carried by a context, or an empty string
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The context attribute processor
+func NewContextAttribute(next sdktrace.SpanProcessor, key attribute.Key, value func(context.Context) string) sdktrace.SpanProcessor {
+	return &contextAttribute{wrapper: wrapper{next: next}, key: key, value: value}
+}
+
+func (p *contextAttribute) OnStart(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	if v := p.value(ctx); v != "" {
+		s.SetAttributes(p.key.String(v))
+	}
+
+	p.next.OnStart(ctx, s)
+}
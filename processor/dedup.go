@@ -0,0 +1,249 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanDedupCountKey is the attribute carrying the number of identical spans aggregated by NewDedup
+// into a single span.
+const SpanDedupCountKey = attribute.Key("span.dedup.count")
+
+// DefaultDedupMaxPending bounds the aggregated spans held by NewDedup when
+// DedupOptions.MaxPending is not set.
+const DefaultDedupMaxPending = 1024
+
+// DedupOptions configures the processor created by NewDedup.
+type DedupOptions struct {
+	// Window is how long the first of identical spans is held while the next ones are aggregated
+	// into it.
+	Window time.Duration
+	// KeyAttributes are the attributes telling spans apart, every attribute being used when empty.
+	KeyAttributes []attribute.Key
+	// MaxPending bounds the aggregated spans held at once, DefaultDedupMaxPending is used when
+	// zero. Spans ending while it is reached are handed over as is.
+	MaxPending int
+	// Clock schedules the flushes, clock.Real is used when nil.
+	Clock clock.Clock
+	// ProfilingLabels labels the flush loop goroutine with the "span_dedup"
+	// ProfilingComponentLabel pprof label.
+	ProfilingLabels bool
+}
+
+// dedupGroup identifies the sibling spans, sharing their trace and parent, among which
+// consecutive identical spans are aggregated.
+type dedupGroup struct {
+	trace  trace.TraceID
+	parent trace.SpanID
+}
+
+// dedupKey identifies identical sibling spans.
+type dedupKey struct {
+	name  string
+	kind  trace.SpanKind
+	code  codes.Code
+	attrs attribute.Distinct
+}
+
+// dedupEntry is a span holding the identical spans ended after it.
+type dedupEntry struct {
+	key   dedupKey
+	first sdktrace.ReadOnlySpan
+	end   time.Time
+	count int64
+	held  time.Time
+}
+
+type dedup struct {
+	wrapper
+	opts DedupOptions
+
+	mu      sync.Mutex
+	pending map[dedupGroup]*dedupEntry
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewDedup creates a SpanProcessor aggregating consecutive identical spans, typically emitted in
+// tight loops by chatty instrumentation, into a single span handed to the next processor, and
+// starts its flush loop. Only sibling spans, sharing their trace and parent span, are aggregated,
+// so spans of different traces are never merged. Siblings are identical when they share their
+// name, kind, status code and KeyAttributes values. The first of identical siblings is held for
+// Window, the next ones ending meanwhile being dropped, and it is then handed over ending when the
+// last one ended and carrying their number in the SpanDedupCountKey attribute. A different sibling
+// ending meanwhile breaks the series: the held span is handed over right away and the new one is
+// held instead. A span without duplicates is handed over unchanged, only delayed.
+//
+// Aggregation trades fidelity for volume: the durations, events and links of the dropped spans
+// are lost, the other attributes are the ones of the first span, the aggregated span covers the
+// whole series rather than a single operation, and the children of the dropped spans keep
+// referencing them as parent, so backends show them as orphans within the trace.
+//
+// Parameters:
+//   - next: The processor receiving the spans
+//   - opts: The aggregation options, a non-positive Window disabling the processor
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The aggregating processor
+func NewDedup(next sdktrace.SpanProcessor, opts DedupOptions) sdktrace.SpanProcessor {
+	if opts.Window <= 0 {
+		return next
+	}
+	if opts.MaxPending <= 0 {
+		opts.MaxPending = DefaultDedupMaxPending
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.Real()
+	}
+
+	d := &dedup{
+		wrapper: wrapper{next: next},
+		opts:    opts,
+		pending: map[dedupGroup]*dedupEntry{},
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	start(opts.ProfilingLabels, "span_dedup", d.run)
+
+	return d
+}
+
+func (d *dedup) OnEnd(s sdktrace.ReadOnlySpan) {
+	group := dedupGroup{trace: s.SpanContext().TraceID(), parent: s.Parent().SpanID()}
+	key := d.key(s)
+
+	d.mu.Lock()
+	previous, ok := d.pending[group]
+	if ok && previous.key == key {
+		previous.count++
+		if s.EndTime().After(previous.end) {
+			previous.end = s.EndTime()
+		}
+		d.mu.Unlock()
+		return
+	}
+	if !ok && len(d.pending) >= d.opts.MaxPending {
+		d.mu.Unlock()
+		d.next.OnEnd(s)
+		return
+	}
+	d.pending[group] = &dedupEntry{key: key, first: s, end: s.EndTime(), count: 1, held: d.opts.Clock.Now()}
+	d.mu.Unlock()
+
+	// A different sibling ended, the series of the previous span is over.
+	if ok {
+		d.handOver(previous)
+	}
+}
+
+func (d *dedup) ForceFlush(ctx context.Context) error {
+	d.flush(time.Time{})
+
+	return d.next.ForceFlush(ctx)
+}
+
+func (d *dedup) Shutdown(ctx context.Context) error {
+	d.stopOnce.Do(func() {
+		close(d.stop)
+		<-d.done
+	})
+	d.flush(time.Time{})
+
+	return d.next.Shutdown(ctx)
+}
+
+func (d *dedup) run() {
+	defer close(d.done)
+
+	ticker := d.opts.Clock.NewTicker(max(d.opts.Window/2, time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.Chan():
+			d.flush(d.opts.Clock.Now().Add(-d.opts.Window))
+		case <-d.stop:
+			return
+		}
+	}
+}
+
+// flush hands over the spans held since before deadline, every held span when deadline is zero.
+func (d *dedup) flush(deadline time.Time) {
+	var expired []*dedupEntry
+
+	d.mu.Lock()
+	for group, entry := range d.pending {
+		if deadline.IsZero() || entry.held.Before(deadline) {
+			expired = append(expired, entry)
+			delete(d.pending, group)
+		}
+	}
+	d.mu.Unlock()
+
+	for _, entry := range expired {
+		d.handOver(entry)
+	}
+}
+
+// handOver hands the held span to the next processor, aggregating its identical siblings.
+func (d *dedup) handOver(entry *dedupEntry) {
+	if entry.count == 1 {
+		d.next.OnEnd(entry.first)
+		return
+	}
+
+	d.next.OnEnd(&dedupSpan{
+		ReadOnlySpan: entry.first,
+		end:          entry.end,
+		attrs:        append(slices.Clip(entry.first.Attributes()), SpanDedupCountKey.Int64(entry.count)),
+	})
+}
+
+func (d *dedup) key(s sdktrace.ReadOnlySpan) dedupKey {
+	var attrs attribute.Set
+	if len(d.opts.KeyAttributes) == 0 {
+		attrs = attribute.NewSet(s.Attributes()...)
+	} else {
+		attrs, _ = attribute.NewSetWithFiltered(s.Attributes(), func(kv attribute.KeyValue) bool {
+			return slices.Contains(d.opts.KeyAttributes, kv.Key)
+		})
+	}
+
+	return dedupKey{
+		name:  s.Name(),
+		kind:  s.SpanKind(),
+		code:  s.Status().Code,
+		attrs: attrs.Equivalent(),
+	}
+}
+
+// dedupSpan is the first of identical siblings, ending with the last one and carrying their number.
+type dedupSpan struct {
+	sdktrace.ReadOnlySpan
+	end   time.Time
+	attrs []attribute.KeyValue
+}
+
+func (s *dedupSpan) EndTime() time.Time {
+	return s.end
+}
+
+func (s *dedupSpan) Attributes() []attribute.KeyValue {
+	return s.attrs
+}
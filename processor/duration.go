@@ -0,0 +1,44 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type minDuration struct {
+	wrapper
+	min time.Duration
+}
+
+// NewMinDuration creates a SpanProcessor dropping the spans lasting less than min, unless their
+// status is an error, and handing the others to the next processor. It cuts the export volume of
+// fast, uninteresting operations while keeping the slow ones. Children of a dropped span keep
+// referencing it as parent, so backends show them as orphans.
+//
+// Parameters:
+//   - next: The processor receiving the spans kept
+//   - min: The minimum duration of the spans kept, a non-positive duration keeping every span
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The filtering processor
+func NewMinDuration(next sdktrace.SpanProcessor, min time.Duration) sdktrace.SpanProcessor {
+	if min <= 0 {
+		return next
+	}
+
+	return &minDuration{wrapper: wrapper{next: next}, min: min}
+}
+
+func (p *minDuration) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.EndTime().Sub(s.StartTime()) < p.min && s.Status().Code != codes.Error {
+		return
+	}
+
+	p.next.OnEnd(s)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type errorBiased struct {
+	wrapper
+	tail *TailSampler
+}
+
+// NewErrorBiased creates a SpanProcessor exporting the traces containing an error even when head
+// sampling decided not to sample them. Sampled spans are handed to the next processor right away,
+// while spans recorded without being sampled (see sampler.NewRecordDropped) are buffered per trace
+// as by NewTailSampler and, when the trace contains a span with an error status, handed to the next
+// processor flagged as sampled. Successful unsampled traces are dropped as usual.
+//
+// The buffer window and memory bounds are configured by opts, whose Rules default to KeepErrors.
+// Unsampled spans of new traces ending while the buffer is full are dropped.
+//
+// Parameters:
+//   - next: The processor spans are handed to
+//   - opts: The buffering options of the unsampled spans
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The error-biased processor
+func NewErrorBiased(next sdktrace.SpanProcessor, opts TailSamplerOptions) sdktrace.SpanProcessor {
+	// Unsampled spans that can't be buffered must not be exported undecided.
+	opts.DropWhenFull = true
+
+	return &errorBiased{
+		wrapper: wrapper{next: next},
+		tail:    NewTailSampler(next, opts),
+	}
+}
+
+func (p *errorBiased) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+		return
+	}
+
+	p.tail.OnEnd(&sampledSpan{ReadOnlySpan: s})
+}
+
+// Shutdown decides the buffered traces and shuts the next processor down.
+func (p *errorBiased) Shutdown(ctx context.Context) error {
+	return p.tail.Shutdown(ctx)
+}
+
+// ForceFlush decides the buffered traces and flushes the next processor.
+func (p *errorBiased) ForceFlush(ctx context.Context) error {
+	return p.tail.ForceFlush(ctx)
+}
+
+// sampledSpan reports the span context of the wrapped span as sampled.
+type sampledSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+func (s *sampledSpan) SpanContext() trace.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
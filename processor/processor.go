@@ -0,0 +1,56 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package processor provides OpenTelemetry span processors used by Goxkit applications.
+// Most of them wrap the next processor of the pipeline (typically the batch span processor)
+// and transform or filter the spans before handing them over, so that they can be chained
+// in front of the export.
+package processor
+
+import (
+	"context"
+	"runtime/pprof"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// wrapper forwards every call to the next processor. Processors embed it and
+// override the methods they need.
+type wrapper struct {
+	next sdktrace.SpanProcessor
+}
+
+func (w *wrapper) OnStart(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	w.next.OnStart(ctx, s)
+}
+
+func (w *wrapper) OnEnd(s sdktrace.ReadOnlySpan) {
+	w.next.OnEnd(s)
+}
+
+func (w *wrapper) Shutdown(ctx context.Context) error {
+	return w.next.Shutdown(ctx)
+}
+
+func (w *wrapper) ForceFlush(ctx context.Context) error {
+	return w.next.ForceFlush(ctx)
+}
+
+// ProfilingComponentLabel is the pprof label key identifying the background goroutines of
+// the processors started with profiling labels, and the goroutines they start.
+const ProfilingComponentLabel = "otel.component"
+
+// start runs fn in a new goroutine, labeled with the component name under
+// ProfilingComponentLabel when labeled is set, so that CPU and goroutine profiles
+// attribute the telemetry overhead.
+func start(labeled bool, component string, fn func()) {
+	if !labeled {
+		go fn()
+		return
+	}
+
+	go pprof.Do(context.Background(), pprof.Labels(ProfilingComponentLabel, component), func(context.Context) {
+		fn()
+	})
+}
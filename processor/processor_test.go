@@ -0,0 +1,61 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goxkit/otel/exporter"
+	"github.com/goxkit/otel/redact"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// newChain builds the processor chain assembled by otel.Setup, with every optional
+// processor enabled, in front of a Batch exporting to a no-op exporter.
+func newChain(b *testing.B) sdktrace.SpanProcessor {
+	b.Helper()
+
+	rules, err := ParseSpanNameRules([]string{`/[0-9]+(/|$)=>/{id}$1`})
+	if err != nil {
+		b.Fatalf("ParseSpanNameRules() error = %v, want nil", err)
+	}
+	redactRules, err := redact.ParseRules([]string{`redact:key:^user\.email$`})
+	if err != nil {
+		b.Fatalf("ParseRules() error = %v, want nil", err)
+	}
+
+	var sp sdktrace.SpanProcessor = NewSpanNameNormalizer(NewBatch(exporter.NewDiscard(), BatchOptions{}), rules)
+	sp = NewRedactor(sp, redact.New(redactRules))
+	sp = NewDedup(sp, DedupOptions{Window: time.Second})
+	sp = NewMinDuration(sp, time.Nanosecond)
+	sp = NewTailSampler(sp, TailSamplerOptions{Window: time.Second})
+	sp = NewErrorBiased(sp, TailSamplerOptions{Window: time.Second})
+	sp = NewCallerInfo(sp)
+	sp = NewContextAttribute(sp, "tenant.id", func(context.Context) string { return "acme" })
+	sp = NewStaticAttributes(sp, attribute.String("deployment.environment", "bench"))
+	sp = NewClockSkew(sp, ClockSkewOptions{MaxSkew: time.Minute})
+	sp = NewWatchdog(sp, WatchdogOptions{MaxDuration: time.Minute})
+
+	return sp
+}
+
+func BenchmarkProcessorChainStartEnd(b *testing.B) {
+	sp := newChain(b)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	tracer := tp.Tracer("bench")
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_, span := tracer.Start(ctx, "GET /users/42")
+		span.SetAttributes(attribute.String("user.email", "jane@example.com"))
+		span.End()
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"github.com/goxkit/otel/redact"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type redactor struct {
+	wrapper
+	redactor *redact.Redactor
+}
+
+// NewRedactor creates a SpanProcessor applying the redaction rules of r to the attributes of the
+// spans and of their events once they end, before handing them to the next processor, so that
+// sensitive values never reach the exporter.
+//
+// Parameters:
+//   - next: The processor receiving the redacted spans
+//   - r: The redactor, a nil redactor leaving spans untouched
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The redacting processor
+func NewRedactor(next sdktrace.SpanProcessor, r *redact.Redactor) sdktrace.SpanProcessor {
+	if r == nil {
+		return next
+	}
+
+	return &redactor{wrapper: wrapper{next: next}, redactor: r}
+}
+
+func (p *redactor) OnEnd(s sdktrace.ReadOnlySpan) {
+	attrs, changed := p.redactor.Attributes(s.Attributes())
+
+	events := s.Events()
+	var redactedEvents []sdktrace.Event
+	for i, event := range events {
+		eventAttrs, eventChanged := p.redactor.Attributes(event.Attributes)
+		if !eventChanged {
+			continue
+		}
+		if redactedEvents == nil {
+			redactedEvents = append([]sdktrace.Event(nil), events...)
+		}
+		redactedEvents[i].Attributes = eventAttrs
+	}
+
+	if changed || redactedEvents != nil {
+		if redactedEvents == nil {
+			redactedEvents = events
+		}
+		s = &redactedSpan{ReadOnlySpan: s, attrs: attrs, events: redactedEvents}
+	}
+
+	p.next.OnEnd(s)
+}
+
+// redactedSpan overrides the attributes and events of an ended span.
+type redactedSpan struct {
+	sdktrace.ReadOnlySpan
+	attrs  []attribute.KeyValue
+	events []sdktrace.Event
+}
+
+func (s *redactedSpan) Attributes() []attribute.KeyValue {
+	return s.attrs
+}
+
+func (s *redactedSpan) Events() []sdktrace.Event {
+	return s.events
+}
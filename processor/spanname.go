@@ -0,0 +1,95 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SpanNameRule replaces the parts of span names matching Pattern with Replacement,
+// following the regexp.Regexp.ReplaceAllString semantics.
+type SpanNameRule struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// ParseSpanNameRules parses span name rules written as "PATTERN=>REPLACEMENT",
+// e.g. `/[0-9]+(/|$)=>/{id}$1`.
+//
+// Parameters:
+//   - rules: The rules to be parsed
+//
+// Returns:
+//   - []SpanNameRule: The parsed rules, in the given order
+//   - error: An error if a rule is malformed or its pattern doesn't compile
+func ParseSpanNameRules(rules []string) ([]SpanNameRule, error) {
+	parsed := make([]SpanNameRule, 0, len(rules))
+	for _, rule := range rules {
+		pattern, replacement, ok := strings.Cut(rule, "=>")
+		if !ok {
+			return nil, fmt.Errorf("invalid span name rule %q: expected PATTERN=>REPLACEMENT", rule)
+		}
+
+		re, err := regexp.Compile(strings.TrimSpace(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid span name rule %q: %w", rule, err)
+		}
+
+		parsed = append(parsed, SpanNameRule{Pattern: re, Replacement: strings.TrimSpace(replacement)})
+	}
+
+	return parsed, nil
+}
+
+type spanNameNormalizer struct {
+	wrapper
+	rules []SpanNameRule
+}
+
+// NewSpanNameNormalizer creates a SpanProcessor normalizing span names with the given rules,
+// applied in order, before handing the spans to the next processor. It prevents high-cardinality
+// names, such as URLs containing ids, from reaching the backend. Names are normalized once the
+// span ends, so names set late by instrumentations (e.g. after route matching) are covered.
+//
+// Parameters:
+//   - next: The processor receiving the normalized spans
+//   - rules: The normalization rules
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The normalizing processor
+func NewSpanNameNormalizer(next sdktrace.SpanProcessor, rules []SpanNameRule) sdktrace.SpanProcessor {
+	if len(rules) == 0 {
+		return next
+	}
+
+	return &spanNameNormalizer{wrapper: wrapper{next: next}, rules: rules}
+}
+
+func (p *spanNameNormalizer) OnEnd(s sdktrace.ReadOnlySpan) {
+	name := s.Name()
+	for _, rule := range p.rules {
+		name = rule.Pattern.ReplaceAllString(name, rule.Replacement)
+	}
+
+	if name != s.Name() {
+		s = &renamedSpan{ReadOnlySpan: s, name: name}
+	}
+
+	p.next.OnEnd(s)
+}
+
+// renamedSpan overrides the name of an ended span.
+type renamedSpan struct {
+	sdktrace.ReadOnlySpan
+	name string
+}
+
+func (s *renamedSpan) Name() string {
+	return s.name
+}
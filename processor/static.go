@@ -0,0 +1,40 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type staticAttributes struct {
+	wrapper
+	attrs []attribute.KeyValue
+}
+
+// NewStaticAttributes creates a SpanProcessor setting the given attributes on each span when it
+// starts, before handing it to the next processor. Unlike resource attributes, they only apply to
+// spans, not to the other signals.
+//
+// Parameters:
+//   - next: The processor spans are handed to
+//   - attrs: The attributes set on every span
+//
+// Returns:
+//   - sdktrace.SpanProcessor: The processor
+func NewStaticAttributes(next sdktrace.SpanProcessor, attrs ...attribute.KeyValue) sdktrace.SpanProcessor {
+	if len(attrs) == 0 {
+		return next
+	}
+
+	return &staticAttributes{wrapper: wrapper{next: next}, attrs: attrs}
+}
+
+func (p *staticAttributes) OnStart(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	s.SetAttributes(p.attrs...)
+	p.next.OnStart(ctx, s)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Default tail sampling settings.
+const (
+	DefaultTailWindow           = 2 * time.Second
+	DefaultTailMaxTraces        = 10000
+	DefaultTailMaxSpansPerTrace = 1000
+)
+
+// TailRule decides from the buffered spans of a trace whether the trace is kept.
+type TailRule func(spans []sdktrace.ReadOnlySpan) bool
+
+// KeepErrors returns a TailRule keeping the traces containing a span with an error status.
+func KeepErrors() TailRule {
+	return func(spans []sdktrace.ReadOnlySpan) bool {
+		for _, s := range spans {
+			if s.Status().Code == codes.Error {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// KeepSlowerThan returns a TailRule keeping the traces containing a span lasting at least d.
+func KeepSlowerThan(d time.Duration) TailRule {
+	return func(spans []sdktrace.ReadOnlySpan) bool {
+		for _, s := range spans {
+			if s.EndTime().Sub(s.StartTime()) >= d {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// TailSamplerOptions configures a TailSampler. Zero values select the defaults.
+type TailSamplerOptions struct {
+	// Window is how long the spans of a trace are buffered, from its first ended span,
+	// before the keep or drop decision is made.
+	Window time.Duration
+	// MaxTraces is the maximum number of traces buffered at once. Spans of new traces
+	// ending while the buffer is full are passed through undecided, or dropped when
+	// DropWhenFull is set.
+	MaxTraces int
+	// DropWhenFull drops the spans that can't be buffered instead of passing them through.
+	DropWhenFull bool
+	// MaxSpansPerTrace is the maximum number of spans buffered for a trace. A trace
+	// reaching it is decided right away.
+	MaxSpansPerTrace int
+	// Rules keep a trace when any of them matches, KeepErrors is used when empty.
+	Rules []TailRule
+	// Clock schedules the decisions, clock.Real is used when nil.
+	Clock clock.Clock
+	// ProfilingLabels labels the decision loop goroutine with the "tail_sampler"
+	// ProfilingComponentLabel pprof label.
+	ProfilingLabels bool
+}
+
+type tailTrace struct {
+	first time.Time
+	spans []sdktrace.ReadOnlySpan
+}
+
+// TailSampler is a SpanProcessor buffering the spans of each trace for a short window and then
+// keeping or dropping the whole trace according to rules evaluated on all its buffered spans,
+// e.g. keeping every trace containing an error. Kept spans are handed to the next processor.
+type TailSampler struct {
+	wrapper
+	opts TailSamplerOptions
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*tailTrace
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTailSampler creates a TailSampler handing the spans of kept traces to the next processor
+// and starts its decision loop.
+//
+// Decisions are made per buffered window: spans of a trace ending more than Window after its
+// first span are buffered and decided again on their own. Memory is bounded by MaxTraces and
+// MaxSpansPerTrace.
+//
+// Parameters:
+//   - next: The processor spans of kept traces are handed to
+//   - opts: The tail sampling options
+//
+// Returns:
+//   - *TailSampler: The tail sampling processor
+func NewTailSampler(next sdktrace.SpanProcessor, opts TailSamplerOptions) *TailSampler {
+	if opts.Window <= 0 {
+		opts.Window = DefaultTailWindow
+	}
+	if opts.MaxTraces <= 0 {
+		opts.MaxTraces = DefaultTailMaxTraces
+	}
+	if opts.MaxSpansPerTrace <= 0 {
+		opts.MaxSpansPerTrace = DefaultTailMaxSpansPerTrace
+	}
+	if len(opts.Rules) == 0 {
+		opts.Rules = []TailRule{KeepErrors()}
+	}
+	if opts.Clock == nil {
+		opts.Clock = clock.Real()
+	}
+
+	t := &TailSampler{
+		wrapper: wrapper{next: next},
+		opts:    opts,
+		traces:  map[trace.TraceID]*tailTrace{},
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	start(t.opts.ProfilingLabels, "tail_sampler", t.run)
+
+	return t
+}
+
+// OnEnd buffers the sampled span with the other spans of its trace.
+func (t *TailSampler) OnEnd(s sdktrace.ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() {
+		return
+	}
+
+	traceID := s.SpanContext().TraceID()
+
+	t.mu.Lock()
+	tt, ok := t.traces[traceID]
+	if !ok {
+		if len(t.traces) >= t.opts.MaxTraces {
+			t.mu.Unlock()
+			if !t.opts.DropWhenFull {
+				t.next.OnEnd(s)
+			}
+			return
+		}
+		tt = &tailTrace{first: t.opts.Clock.Now()}
+		t.traces[traceID] = tt
+	}
+	tt.spans = append(tt.spans, s)
+
+	var full []sdktrace.ReadOnlySpan
+	if len(tt.spans) >= t.opts.MaxSpansPerTrace {
+		full = tt.spans
+		delete(t.traces, traceID)
+	}
+	t.mu.Unlock()
+
+	if full != nil {
+		t.decide(full)
+	}
+}
+
+// ForceFlush decides every buffered trace and flushes the next processor.
+func (t *TailSampler) ForceFlush(ctx context.Context) error {
+	t.decideOlderThan(time.Time{}, true)
+	return t.next.ForceFlush(ctx)
+}
+
+// Shutdown stops the decision loop, decides every buffered trace and shuts the next processor down.
+func (t *TailSampler) Shutdown(ctx context.Context) error {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+		<-t.done
+	})
+
+	t.decideOlderThan(time.Time{}, true)
+	return t.next.Shutdown(ctx)
+}
+
+func (t *TailSampler) run() {
+	defer close(t.done)
+
+	ticker := t.opts.Clock.NewTicker(t.opts.Window / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.Chan():
+			t.decideOlderThan(t.opts.Clock.Now().Add(-t.opts.Window), false)
+		case <-t.stop:
+			return
+		}
+	}
+}
+
+// decideOlderThan decides the traces whose first span was buffered before deadline,
+// or every trace when all is set.
+func (t *TailSampler) decideOlderThan(deadline time.Time, all bool) {
+	var expired [][]sdktrace.ReadOnlySpan
+
+	t.mu.Lock()
+	for traceID, tt := range t.traces {
+		if all || !tt.first.After(deadline) {
+			expired = append(expired, tt.spans)
+			delete(t.traces, traceID)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, spans := range expired {
+		t.decide(spans)
+	}
+}
+
+// decide hands the spans of the trace to the next processor when a rule keeps it.
+func (t *TailSampler) decide(spans []sdktrace.ReadOnlySpan) {
+	for _, rule := range t.opts.Rules {
+		if rule(spans) {
+			for _, s := range spans {
+				t.next.OnEnd(s)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanTimedOutKey is the attribute set to true on the spans ended by a Watchdog.
+const SpanTimedOutKey = attribute.Key("span.timed_out")
+
+// WatchdogOptions configures a Watchdog.
+type WatchdogOptions struct {
+	// MaxDuration is how long a span may stay active before the watchdog ends it.
+	MaxDuration time.Duration
+	// Clock schedules the checks, clock.Real is used when nil.
+	Clock clock.Clock
+	// ProfilingLabels labels the check loop goroutine with the "span_watchdog"
+	// ProfilingComponentLabel pprof label.
+	ProfilingLabels bool
+}
+
+// Watchdog is a SpanProcessor tracking the active spans and force-ending the ones lasting longer
+// than MaxDuration, marked with the SpanTimedOutKey attribute. It catches the instrumentation bugs
+// forgetting to end spans, which otherwise hold memory and are never exported.
+type Watchdog struct {
+	wrapper
+	opts WatchdogOptions
+
+	mu     sync.Mutex
+	active map[trace.SpanID]sdktrace.ReadWriteSpan
+
+	timedOut atomic.Uint64
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewWatchdog creates a Watchdog handing the spans to the next processor and starts its check
+// loop, which runs every quarter of MaxDuration, at most every second, so spans are ended
+// somewhat after MaxDuration.
+//
+// Parameters:
+//   - next: The processor receiving the spans
+//   - opts: The watchdog options, MaxDuration must be positive
+//
+// Returns:
+//   - *Watchdog: The watchdog processor
+func NewWatchdog(next sdktrace.SpanProcessor, opts WatchdogOptions) *Watchdog {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real()
+	}
+
+	w := &Watchdog{
+		wrapper: wrapper{next: next},
+		opts:    opts,
+		active:  map[trace.SpanID]sdktrace.ReadWriteSpan{},
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	start(opts.ProfilingLabels, "span_watchdog", w.run)
+
+	return w
+}
+
+// TimedOut returns the number of spans ended by the watchdog.
+func (w *Watchdog) TimedOut() uint64 {
+	return w.timedOut.Load()
+}
+
+func (w *Watchdog) OnStart(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	w.mu.Lock()
+	w.active[s.SpanContext().SpanID()] = s
+	w.mu.Unlock()
+
+	w.next.OnStart(ctx, s)
+}
+
+func (w *Watchdog) OnEnd(s sdktrace.ReadOnlySpan) {
+	w.mu.Lock()
+	delete(w.active, s.SpanContext().SpanID())
+	w.mu.Unlock()
+
+	w.next.OnEnd(s)
+}
+
+func (w *Watchdog) Shutdown(ctx context.Context) error {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+		<-w.done
+	})
+
+	return w.next.Shutdown(ctx)
+}
+
+func (w *Watchdog) run() {
+	defer close(w.done)
+
+	ticker := w.opts.Clock.NewTicker(max(min(w.opts.MaxDuration/4, time.Second), time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.Chan():
+			w.endOlderThan(w.opts.Clock.Now().Add(-w.opts.MaxDuration))
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// endOlderThan ends the active spans started before deadline.
+func (w *Watchdog) endOlderThan(deadline time.Time) {
+	var expired []sdktrace.ReadWriteSpan
+
+	w.mu.Lock()
+	for _, s := range w.active {
+		if s.StartTime().Before(deadline) {
+			expired = append(expired, s)
+		}
+	}
+	w.mu.Unlock()
+
+	// Ending a span calls OnEnd, which removes it from the active spans.
+	for _, s := range expired {
+		if !s.IsRecording() {
+			continue
+		}
+
+		s.SetAttributes(SpanTimedOutKey.Bool(true))
+		s.End()
+		w.timedOut.Add(1)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// EnsurePropagators sets the global text map propagator to the composition of the named
+// propagators ("tracecontext" and "baggage", both used when no name is given) unless one is already
+// configured, so that library code can guarantee propagation works even when the application did
+// not call Setup. An already configured propagator is never overridden, which makes the call
+// idempotent.
+//
+// Parameters:
+//   - names: The propagator names, following the OTEL_PROPAGATORS conventions (see NewPropagator)
+//
+// Returns:
+//   - error: An error if a propagator name is not supported
+func EnsurePropagators(names ...string) error {
+	propagator, err := NewPropagator(names...)
+	if err != nil {
+		return err
+	}
+
+	// The default global propagator propagates no field until a propagator is set.
+	if len(otel.GetTextMapPropagator().Fields()) > 0 {
+		return nil
+	}
+
+	otel.SetTextMapPropagator(propagator)
+	return nil
+}
+
+// NewPropagator creates the composition of the named propagators, following the OTEL_PROPAGATORS
+// conventions, "tracecontext" and "baggage" being used when no name is given:
+//   - "tracecontext": the W3C traceparent and tracestate headers;
+//   - "baggage": the W3C baggage header;
+//   - "b3": the B3 single header ("b3: {TraceId}-{SpanId}-{SamplingState}");
+//   - "b3multi": the B3 multiple headers (X-B3-TraceId, X-B3-SpanId and X-B3-Sampled).
+//
+// The B3 propagators only differ by the headers they inject: both extract either format, the single
+// header taking precedence, so that the name must match what the downstream services expect.
+//
+// Parameters:
+//   - names: The propagator names
+//
+// Returns:
+//   - propagation.TextMapPropagator: The composite propagator
+//   - error: An error if a propagator name is not supported
+func NewPropagator(names ...string) (propagation.TextMapPropagator, error) {
+	if len(names) == 0 {
+		names = []string{"tracecontext", "baggage"}
+	}
+
+	propagators := make([]propagation.TextMapPropagator, 0, len(names))
+	for _, name := range names {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "tracecontext":
+			propagators = append(propagators, propagation.TraceContext{})
+		case "baggage":
+			propagators = append(propagators, propagation.Baggage{})
+		case "b3":
+			propagators = append(propagators, b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)))
+		case "b3multi":
+			propagators = append(propagators, b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)))
+		default:
+			return nil, fmt.Errorf("unsupported otel propagator %q: expected tracecontext, baggage, b3 or b3multi", name)
+		}
+	}
+
+	return propagation.NewCompositeTextMapPropagator(propagators...), nil
+}
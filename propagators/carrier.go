@@ -0,0 +1,36 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package propagators
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Inject writes the span context and baggage carried by ctx into the carrier using the global
+// text map propagator. It is shared by the messaging carrier adapters (see otelsarama and
+// otelamqp), so that every transport propagates the same fields.
+//
+// Parameters:
+//   - ctx: Context carrying the span context and baggage
+//   - carrier: The carrier the propagation fields are written to
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract returns a copy of ctx carrying the remote span context and baggage read from the
+// carrier using the global text map propagator, the inverse of Inject.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - carrier: The carrier the propagation fields are read from
+//
+// Returns:
+//   - context.Context: The context carrying the remote span context and baggage
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package propagators
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataCarrier is a TextMapCarrier reading and writing gRPC metadata. Keys are lowercased by
+// metadata.MD, as required by HTTP/2, and only the first value of a key is read.
+type MetadataCarrier metadata.MD
+
+var _ propagation.TextMapCarrier = MetadataCarrier{}
+
+// Get returns the first value of the metadata with the given key.
+func (c MetadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set stores the metadata, replacing its previous values.
+func (c MetadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys returns the keys of the metadata.
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ContextFromGRPCMetadata returns a copy of ctx carrying the remote span context and baggage read
+// from incoming gRPC metadata using the global text map propagator. It is the manual counterpart
+// of the gRPC server instrumentation, for handlers it doesn't cover such as unknown service
+// handlers or raw streams.
+//
+// Missing or invalid propagation fields are ignored: ctx is then returned unchanged.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - md: The incoming metadata, read from ctx with metadata.FromIncomingContext when nil
+//
+// Returns:
+//   - context.Context: The context carrying the remote span context and baggage
+func ContextFromGRPCMetadata(ctx context.Context, md metadata.MD) context.Context {
+	if md == nil {
+		md, _ = metadata.FromIncomingContext(ctx)
+	}
+	if len(md) == 0 {
+		return ctx
+	}
+
+	return Extract(ctx, MetadataCarrier(md))
+}
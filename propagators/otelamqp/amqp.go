@@ -0,0 +1,74 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package otelamqp adapts AMQP headers of github.com/rabbitmq/amqp091-go messages to the
+// OpenTelemetry propagation.TextMapCarrier interface.
+package otelamqp
+
+import (
+	"context"
+
+	"github.com/goxkit/otel/propagators"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// TableCarrier is a TextMapCarrier reading and writing an AMQP headers table. Values that
+// are not strings or byte slices are ignored by Get. The table must not be nil for Set to work,
+// Inject takes care of it for published messages.
+type TableCarrier amqp.Table
+
+var _ propagation.TextMapCarrier = TableCarrier{}
+
+// Get returns the value of the header with the given key.
+func (c TableCarrier) Get(key string) string {
+	switch v := c[key].(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
+
+// Set stores the header.
+func (c TableCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+// Keys returns the keys of the headers.
+func (c TableCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Inject writes the span context and baggage carried by ctx into the headers of msg
+// using the global text map propagator, creating the headers table when needed.
+//
+// Parameters:
+//   - ctx: Context carrying the span context and baggage
+//   - msg: The message to be published
+func Inject(ctx context.Context, msg *amqp.Publishing) {
+	if msg.Headers == nil {
+		msg.Headers = amqp.Table{}
+	}
+	propagators.Inject(ctx, TableCarrier(msg.Headers))
+}
+
+// Extract returns a copy of ctx carrying the remote span context and baggage read from the
+// headers of msg using the global text map propagator.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - msg: The delivered message
+//
+// Returns:
+//   - context.Context: The context carrying the remote span context and baggage
+func Extract(ctx context.Context, msg *amqp.Delivery) context.Context {
+	return propagators.Extract(ctx, TableCarrier(msg.Headers))
+}
@@ -0,0 +1,23 @@
+module github.com/goxkit/otel/propagators/otelamqp
+
+go 1.24.3
+
+require (
+	github.com/goxkit/otel v0.0.0-00010101000000-000000000000
+	github.com/rabbitmq/amqp091-go v1.10.0
+	go.opentelemetry.io/otel v1.36.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.36.0 // indirect
+	go.opentelemetry.io/otel/trace v1.36.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	google.golang.org/grpc v1.72.2 // indirect
+)
+
+replace github.com/goxkit/otel => ../..
+
+replace github.com/goxkit/configs => ../../../configs
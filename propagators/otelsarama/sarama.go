@@ -0,0 +1,138 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package otelsarama adapts Kafka record headers of github.com/IBM/sarama messages to the
+// OpenTelemetry propagation.TextMapCarrier interface.
+package otelsarama
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/goxkit/otel/propagators"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// ProducerCarrier is a TextMapCarrier reading and writing the headers of a message to be produced.
+// Setting a key replaces every header with the same key.
+type ProducerCarrier struct {
+	msg *sarama.ProducerMessage
+}
+
+var _ propagation.TextMapCarrier = ProducerCarrier{}
+
+// NewProducerCarrier creates a ProducerCarrier over the headers of msg.
+//
+// Parameters:
+//   - msg: The message to be produced
+//
+// Returns:
+//   - ProducerCarrier: The carrier
+func NewProducerCarrier(msg *sarama.ProducerMessage) ProducerCarrier {
+	return ProducerCarrier{msg: msg}
+}
+
+// Get returns the value of the first header with the given key.
+func (c ProducerCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// Set stores the header, replacing the headers with the same key.
+func (c ProducerCarrier) Set(key, value string) {
+	headers := c.msg.Headers[:0]
+	for _, h := range c.msg.Headers {
+		if string(h.Key) != key {
+			headers = append(headers, h)
+		}
+	}
+	c.msg.Headers = append(headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+// Keys returns the keys of the headers.
+func (c ProducerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		keys = append(keys, string(h.Key))
+	}
+	return keys
+}
+
+// ConsumerCarrier is a TextMapCarrier reading and writing the headers of a consumed message.
+// Setting a key replaces every header with the same key.
+type ConsumerCarrier struct {
+	msg *sarama.ConsumerMessage
+}
+
+var _ propagation.TextMapCarrier = ConsumerCarrier{}
+
+// NewConsumerCarrier creates a ConsumerCarrier over the headers of msg.
+//
+// Parameters:
+//   - msg: The consumed message
+//
+// Returns:
+//   - ConsumerCarrier: The carrier
+func NewConsumerCarrier(msg *sarama.ConsumerMessage) ConsumerCarrier {
+	return ConsumerCarrier{msg: msg}
+}
+
+// Get returns the value of the first header with the given key.
+func (c ConsumerCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if h != nil && string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+// Set stores the header, replacing the headers with the same key.
+func (c ConsumerCarrier) Set(key, value string) {
+	headers := c.msg.Headers[:0]
+	for _, h := range c.msg.Headers {
+		if h != nil && string(h.Key) != key {
+			headers = append(headers, h)
+		}
+	}
+	c.msg.Headers = append(headers, &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+// Keys returns the keys of the headers.
+func (c ConsumerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		if h != nil {
+			keys = append(keys, string(h.Key))
+		}
+	}
+	return keys
+}
+
+// Inject writes the span context and baggage carried by ctx into the headers of msg
+// using the global text map propagator.
+//
+// Parameters:
+//   - ctx: Context carrying the span context and baggage
+//   - msg: The message to be produced
+func Inject(ctx context.Context, msg *sarama.ProducerMessage) {
+	propagators.Inject(ctx, NewProducerCarrier(msg))
+}
+
+// Extract returns a copy of ctx carrying the remote span context and baggage read from the
+// headers of msg using the global text map propagator.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - msg: The consumed message
+//
+// Returns:
+//   - context.Context: The context carrying the remote span context and baggage
+func Extract(ctx context.Context, msg *sarama.ConsumerMessage) context.Context {
+	return propagators.Extract(ctx, NewConsumerCarrier(msg))
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package propagators
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// RoundTrip injects the span context and baggage carried by ctx into a carrier with the global
+// text map propagator and extracts them back into a fresh context, as a downstream service would.
+// Tests can assert that the returned context carries the expected span context and baggage,
+// catching propagator misconfigurations (e.g. Setup skipped or baggage not propagated) early.
+//
+// Parameters:
+//   - ctx: Context carrying the span context and baggage
+//
+// Returns:
+//   - context.Context: A new context carrying only what survived propagation
+func RoundTrip(ctx context.Context) context.Context {
+	carrier := propagation.MapCarrier{}
+	Inject(ctx, carrier)
+
+	return Extract(context.Background(), carrier)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package propagators
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const tracestateHeader = "tracestate"
+
+// SQLCommentFromContext returns a sqlcommenter comment (e.g. "/*traceparent='00-...-01'*/")
+// carrying the W3C trace context of the span context carried by ctx, to be appended to SQL
+// queries so that APM-aware databases can correlate them to traces. The tracestate is included
+// when present. Keys are sorted and values URL-encoded as the sqlcommenter specification requires.
+//
+// Parameters:
+//   - ctx: Context carrying the span context
+//
+// Returns:
+//   - string: The SQL comment, or an empty string when ctx has no valid span context
+func SQLCommentFromContext(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	traceparent := carrier.Get(traceparentHeader)
+	if traceparent == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("/*")
+	b.WriteString(traceparentHeader + "='" + sqlCommentValue(traceparent) + "'")
+	if tracestate := carrier.Get(tracestateHeader); tracestate != "" {
+		b.WriteString("," + tracestateHeader + "='" + sqlCommentValue(tracestate) + "'")
+	}
+	b.WriteString("*/")
+
+	return b.String()
+}
+
+// sqlCommentValue URL-encodes a sqlcommenter value and escapes its single quotes.
+func sqlCommentValue(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "'", `\'`)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package propagators
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const traceparentHeader = "traceparent"
+
+// ContextFromTraceparent returns a copy of ctx carrying the remote span context described by a raw
+// W3C traceparent value (e.g. "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"), so that
+// spans started from it are children of the serialized span. It allows context to travel through
+// systems supporting a single string, such as database comments or log lines.
+//
+// Invalid values are ignored: ctx is then returned unchanged.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - traceparent: The W3C traceparent value
+//
+// Returns:
+//   - context.Context: The context carrying the remote span context
+func ContextFromTraceparent(ctx context.Context, traceparent string) context.Context {
+	carrier := propagation.MapCarrier{traceparentHeader: strings.TrimSpace(traceparent)}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
+
+// TraceparentFromContext returns the W3C traceparent value describing the span context carried
+// by ctx, the inverse of ContextFromTraceparent.
+//
+// Parameters:
+//   - ctx: Context carrying the span context
+//
+// Returns:
+//   - string: The W3C traceparent value, or an empty string when ctx has no valid span context
+func TraceparentFromContext(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	return carrier.Get(traceparentHeader)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package propagators provides helpers around OpenTelemetry context propagation,
+// such as reading and writing W3C tracestate entries on the active span context or
+// serializing it as a raw W3C traceparent value. Its otelsarama and otelamqp subpackages,
+// separate modules keeping the messaging client libraries out of this one, adapt messaging
+// headers to propagation.TextMapCarrier.
+package propagators
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceStateSpan overrides the span context reported by the wrapped span so that the
+// updated tracestate is seen by propagators and by child spans, while the span itself
+// keeps recording as usual.
+type traceStateSpan struct {
+	trace.Span
+	sc trace.SpanContext
+}
+
+func (s *traceStateSpan) SpanContext() trace.SpanContext {
+	return s.sc
+}
+
+// SetTraceStateEntry returns a copy of ctx whose span context carries the given W3C tracestate
+// entry, replacing any previous value for the same key. Spans started from the returned context
+// inherit the entry and the tracecontext propagator injects it into outgoing requests.
+//
+// The span already stored in ctx is not modified, since span contexts are immutable: the entry
+// is only visible through the returned context.
+//
+// Parameters:
+//   - ctx: Context carrying the current span context
+//   - key: The tracestate vendor key
+//   - value: The tracestate value
+//
+// Returns:
+//   - context.Context: The context carrying the updated tracestate
+//   - error: An error if ctx has no valid span context or the entry is not a valid tracestate member
+func SetTraceStateEntry(ctx context.Context, key, value string) (context.Context, error) {
+	span := trace.SpanFromContext(ctx)
+	sc := span.SpanContext()
+	if !sc.IsValid() {
+		return ctx, fmt.Errorf("failed to set tracestate entry %q: no valid span context", key)
+	}
+
+	ts, err := sc.TraceState().Insert(key, value)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to set tracestate entry %q: %w", key, err)
+	}
+
+	return trace.ContextWithSpan(ctx, &traceStateSpan{Span: span, sc: sc.WithTraceState(ts)}), nil
+}
+
+// TraceStateEntry returns the value of the W3C tracestate entry stored under key in the
+// span context carried by ctx, or an empty string when the entry is absent.
+//
+// Parameters:
+//   - ctx: Context carrying the current span context
+//   - key: The tracestate vendor key
+//
+// Returns:
+//   - string: The tracestate value
+func TraceStateEntry(ctx context.Context, key string) string {
+	return trace.SpanContextFromContext(ctx).TraceState().Get(key)
+}
+
+// DeleteTraceStateEntry returns a copy of ctx whose span context no longer carries the
+// W3C tracestate entry stored under key.
+//
+// Parameters:
+//   - ctx: Context carrying the current span context
+//   - key: The tracestate vendor key
+//
+// Returns:
+//   - context.Context: The context carrying the updated tracestate
+func DeleteTraceStateEntry(ctx context.Context, key string) context.Context {
+	span := trace.SpanFromContext(ctx)
+	sc := span.SpanContext()
+	if !sc.IsValid() || sc.TraceState().Get(key) == "" {
+		return ctx
+	}
+
+	return trace.ContextWithSpan(ctx, &traceStateSpan{Span: span, sc: sc.WithTraceState(sc.TraceState().Delete(key))})
+}
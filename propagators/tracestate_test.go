@@ -0,0 +1,100 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package propagators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetTraceStateEntryRoundTrip(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "parent")
+	defer span.End()
+
+	ctx, err := SetTraceStateEntry(ctx, "vendor", "value")
+	if err != nil {
+		t.Fatalf("SetTraceStateEntry() error = %v, want nil", err)
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if got := carrier.Get("tracestate"); got != "vendor=value" {
+		t.Errorf("injected tracestate = %q, want %q", got, "vendor=value")
+	}
+
+	extracted := propagation.TraceContext{}.Extract(context.Background(), carrier)
+	sc := trace.SpanContextFromContext(extracted)
+	if sc.TraceID() != span.SpanContext().TraceID() || sc.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("extracted span context = %v, want the trace and span IDs of %v", sc, span.SpanContext())
+	}
+	if got := TraceStateEntry(extracted, "vendor"); got != "value" {
+		t.Errorf("TraceStateEntry() after Extract = %q, want %q", got, "value")
+	}
+
+	_, remote := tp.Tracer("test").Start(extracted, "remote child")
+	defer remote.End()
+	if got := remote.SpanContext().TraceState().Get("vendor"); got != "value" {
+		t.Errorf("remote child tracestate entry = %q, want %q", got, "value")
+	}
+}
+
+func TestSetTraceStateEntryInheritedByChildSpans(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "parent")
+	defer span.End()
+
+	updated, err := SetTraceStateEntry(ctx, "vendor", "value")
+	if err != nil {
+		t.Fatalf("SetTraceStateEntry() error = %v, want nil", err)
+	}
+
+	childCtx, child := tp.Tracer("test").Start(updated, "child")
+	defer child.End()
+	if got := child.SpanContext().TraceState().Get("vendor"); got != "value" {
+		t.Errorf("child tracestate entry = %q, want %q", got, "value")
+	}
+	if got := TraceStateEntry(childCtx, "vendor"); got != "value" {
+		t.Errorf("TraceStateEntry() in child = %q, want %q", got, "value")
+	}
+
+	_, sibling := tp.Tracer("test").Start(ctx, "sibling")
+	defer sibling.End()
+	if got := sibling.SpanContext().TraceState().Get("vendor"); got != "" {
+		t.Errorf("tracestate entry of a span started from the original context = %q, want none", got)
+	}
+
+	deleted := DeleteTraceStateEntry(updated, "vendor")
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(deleted, carrier)
+	if got := carrier.Get("tracestate"); got != "" {
+		t.Errorf("injected tracestate after DeleteTraceStateEntry = %q, want none", got)
+	}
+}
+
+func TestSetTraceStateEntryErrors(t *testing.T) {
+	if _, err := SetTraceStateEntry(context.Background(), "vendor", "value"); err == nil {
+		t.Error("SetTraceStateEntry() without span context error = nil, want an error")
+	}
+
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "parent")
+	defer span.End()
+
+	if _, err := SetTraceStateEntry(ctx, "Invalid Key", "value"); err == nil || !strings.Contains(err.Error(), "Invalid Key") {
+		t.Errorf("SetTraceStateEntry() with an invalid key error = %v, want an error naming the key", err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/otlpgrpc"
+)
+
+// OTLP transports selectable per signal with OTLPConfigs.TracesProtocol and OTLPConfigs.MetricsProtocol.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+// signalProtocol validates the protocol of a signal, defaulting to ProtocolGRPC.
+func signalProtocol(protocol string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(protocol)) {
+	case "", ProtocolGRPC:
+		return ProtocolGRPC, nil
+	case ProtocolHTTP, "http/protobuf":
+		return ProtocolHTTP, nil
+	default:
+		return "", fmt.Errorf("invalid otel exporter protocol %q: expected grpc or http", protocol)
+	}
+}
+
+// signalEndpoint returns the endpoint of a signal, defaulting to OTLPConfigs.Endpoint.
+func signalEndpoint(cfgs *configs.Configs, endpoint string) string {
+	if endpoint != "" {
+		return endpoint
+	}
+
+	return cfgs.OTLPConfigs.Endpoint
+}
+
+// signalCompression returns the compression of a signal, defaulting to OTLPConfigs.ExporterCompression.
+func signalCompression(cfgs *configs.Configs, compression string) string {
+	if compression != "" {
+		return compression
+	}
+
+	return cfgs.OTLPConfigs.ExporterCompression
+}
+
+// httpEndpoint describes how an OTLP/HTTP exporter reaches endpoint: endpoints written as URLs
+// are used as is, while host:port endpoints rely on the signal default path and on
+// OTLPConfigs.ExporterTLSEnabled.
+type httpEndpoint struct {
+	url      string
+	hostPort string
+	insecure bool
+}
+
+func newHTTPEndpoint(cfgs *configs.Configs, endpoint string) httpEndpoint {
+	if strings.Contains(endpoint, "://") {
+		return httpEndpoint{url: endpoint}
+	}
+
+	target, _, _ := otlpgrpc.NormalizeEndpoint(endpoint)
+	return httpEndpoint{hostPort: target, insecure: !cfgs.OTLPConfigs.ExporterTLSEnabled}
+}
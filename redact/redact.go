@@ -0,0 +1,184 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package redact scrubs sensitive attributes, such as personal data, from telemetry before
+// export. Rules match attribute keys or values with regular expressions and either mask the
+// value or drop the attribute. They are shared by the span processor, the metric view and the
+// log processor wired by Setup from OTLPConfigs.RedactAttributes.
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Mask is the value replacing redacted attribute values.
+const Mask = "***"
+
+// Action is what a Rule does with the attributes it matches.
+type Action string
+
+// Rule actions.
+const (
+	// ActionRedact replaces the attribute value with Mask.
+	ActionRedact Action = "redact"
+	// ActionDrop removes the attribute.
+	ActionDrop Action = "drop"
+)
+
+// Target is the part of the attribute a Rule matches.
+type Target string
+
+// Rule targets.
+const (
+	TargetKey   Target = "key"
+	TargetValue Target = "value"
+)
+
+// Rule applies Action to the attributes whose Target matches Pattern.
+type Rule struct {
+	Action  Action
+	Target  Target
+	Pattern *regexp.Regexp
+}
+
+// ParseRules parses rules written as "ACTION:TARGET:PATTERN", where ACTION is "redact" or "drop"
+// and TARGET is "key" or "value", e.g. `redact:key:^user\.email$` or `drop:value:^\d{16}$`.
+//
+// Parameters:
+//   - rules: The rules to be parsed
+//
+// Returns:
+//   - []Rule: The parsed rules, in the given order
+//   - error: An error if a rule is malformed or its pattern doesn't compile
+func ParseRules(rules []string) ([]Rule, error) {
+	parsed := make([]Rule, 0, len(rules))
+	for _, rule := range rules {
+		parts := strings.SplitN(rule, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid redact rule %q: expected ACTION:TARGET:PATTERN", rule)
+		}
+
+		action := Action(strings.TrimSpace(parts[0]))
+		if action != ActionRedact && action != ActionDrop {
+			return nil, fmt.Errorf("invalid redact rule %q: action must be redact or drop", rule)
+		}
+
+		target := Target(strings.TrimSpace(parts[1]))
+		if target != TargetKey && target != TargetValue {
+			return nil, fmt.Errorf("invalid redact rule %q: target must be key or value", rule)
+		}
+
+		re, err := regexp.Compile(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid redact rule %q: %w", rule, err)
+		}
+
+		parsed = append(parsed, Rule{Action: action, Target: target, Pattern: re})
+	}
+
+	return parsed, nil
+}
+
+// Redactor applies rules to attributes. A drop rule wins over a redact rule matching
+// the same attribute. A nil Redactor leaves attributes untouched.
+type Redactor struct {
+	rules []Rule
+}
+
+// New creates a Redactor applying the given rules.
+//
+// Parameters:
+//   - rules: The redaction rules
+//
+// Returns:
+//   - *Redactor: The redactor, nil when there is no rule
+func New(rules []Rule) *Redactor {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return &Redactor{rules: rules}
+}
+
+// Match returns the action applying to the attribute with the given key and value.
+//
+// Parameters:
+//   - key: The attribute key
+//   - value: The attribute value, as a string
+//
+// Returns:
+//   - Action: The action applying to the attribute
+//   - bool: Whether a rule matches the attribute
+func (r *Redactor) Match(key, value string) (Action, bool) {
+	if r == nil {
+		return "", false
+	}
+
+	var matched Action
+	for _, rule := range r.rules {
+		subject := key
+		if rule.Target == TargetValue {
+			subject = value
+		}
+
+		if !rule.Pattern.MatchString(subject) {
+			continue
+		}
+		if rule.Action == ActionDrop {
+			return ActionDrop, true
+		}
+		matched = rule.Action
+	}
+
+	return matched, matched != ""
+}
+
+// Attributes returns the attributes with the rules applied.
+//
+// Parameters:
+//   - attrs: The attributes
+//
+// Returns:
+//   - []attribute.KeyValue: The redacted attributes, attrs itself when no rule matches
+//   - bool: Whether a rule matched an attribute
+func (r *Redactor) Attributes(attrs []attribute.KeyValue) ([]attribute.KeyValue, bool) {
+	var redacted []attribute.KeyValue
+	for i, kv := range attrs {
+		action, ok := r.Match(string(kv.Key), kv.Value.Emit())
+		if !ok {
+			if redacted != nil {
+				redacted = append(redacted, kv)
+			}
+			continue
+		}
+
+		if redacted == nil {
+			redacted = append(make([]attribute.KeyValue, 0, len(attrs)), attrs[:i]...)
+		}
+		if action == ActionRedact {
+			redacted = append(redacted, kv.Key.String(Mask))
+		}
+	}
+
+	if redacted == nil {
+		return attrs, false
+	}
+	return redacted, true
+}
+
+// Filter returns the attribute filter of metric views keeping the attributes no rule matches.
+// Views can't rewrite values, so redacted attributes are dropped from metrics as well.
+//
+// Returns:
+//   - attribute.Filter: The attribute filter
+func (r *Redactor) Filter() attribute.Filter {
+	return func(kv attribute.KeyValue) bool {
+		_, ok := r.Match(string(kv.Key), kv.Value.Emit())
+		return !ok
+	}
+}
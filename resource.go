@@ -0,0 +1,309 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goxkit/configs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.uber.org/zap"
+)
+
+// DefaultSchemaURL is the semantic-conventions schema URL of the semconv version this
+// package builds against. It is used when OTLPConfigs.SchemaURL is not set.
+const DefaultSchemaURL = semconv.SchemaURL
+
+// DefaultResourceDetectionTimeout is the time budget shared by the resource detectors
+// used when OTLPConfigs.ResourceDetectionTimeout is not set.
+const DefaultResourceDetectionTimeout = 5 * time.Second
+
+var schemaURL atomic.Value
+
+var (
+	detectorsMu sync.Mutex
+	detectors   []resource.Detector
+)
+
+func init() {
+	schemaURL.Store(DefaultSchemaURL)
+}
+
+// RegisterResourceDetector registers a detector run by NewResource, whose resource is merged into
+// the application resource. It lets services contribute bespoke metadata, such as a cluster name
+// fetched from an internal API, and must be called before Setup or NewResource.
+//
+// Detectors share the OTLPConfigs.ResourceDetectionTimeout budget through the context they receive.
+//
+// Parameters:
+//   - detector: The detector to be registered
+func RegisterResourceDetector(detector resource.Detector) {
+	detectorsMu.Lock()
+	defer detectorsMu.Unlock()
+
+	detectors = append(detectors, detector)
+}
+
+func registeredDetectors() []resource.Detector {
+	detectorsMu.Lock()
+	defer detectorsMu.Unlock()
+
+	return append([]resource.Detector(nil), detectors...)
+}
+
+// SchemaURL returns the schema URL attached to the resource and to the instrumentation
+// scopes created by Tracer, Meter and Logger.
+func SchemaURL() string {
+	return schemaURL.Load().(string)
+}
+
+// NewResource creates the Resource describing the application, identified by the service name and
+// namespace from the application configurations and enriched with the telemetry SDK attributes and
+// with the attributes from the OTEL_RESOURCE_ATTRIBUTES environment variable and from the detectors
+// registered with RegisterResourceDetector. OTLPConfigs.ResourceEnvAttributes mappings, written as
+// "ENV=ATTRIBUTE", add attributes holding the values of environment variables when they are set,
+// e.g. "PR_NUMBER=vcs.change.id" to tag the telemetry of CI and preview environments with their
+// pull request so that it can be filtered and purged. Detection is bounded by OTLPConfigs.ResourceDetectionTimeout
+// (DefaultResourceDetectionTimeout when unset), shared by all detectors.
+//
+// The service.name attribute is resolved following the OpenTelemetry specification precedence:
+//  1. the OTEL_SERVICE_NAME environment variable;
+//  2. the service.name entry of the OTEL_RESOURCE_ATTRIBUTES environment variable;
+//  3. the application name from AppConfigs.Name;
+//  4. "unknown_service:" followed by the executable name.
+//
+// All attributes are associated with the schema URL from OTLPConfigs.SchemaURL so that backends
+// interpret them according to the expected semantic conventions. Alternatively,
+// OTLPConfigs.SemconvVersion pins the semantic-conventions version (see SupportedSemconvVersions),
+// guarding the attribute naming against drift across package upgrades. When neither is set, the
+// schema URL of the detected resources is used, falling back to DefaultSchemaURL. The resolved
+// schema URL is also recorded for the instrumentation scopes created by Tracer, Meter and Logger.
+//
+// Detected resources using a different schema URL (e.g. a detector built against another semconv
+// version) don't fail the setup: the conflict is resolved in favor of the configured or pinned
+// schema URL, or of the newest one otherwise, and a warning is logged.
+//
+// Detection failures (e.g. a cloud metadata endpoint unreachable offline) are not fatal: a warning
+// is logged and the resource keeps the attributes detected so far, at least the service name, since
+// telemetry with partial resource information beats no telemetry. Enable OTLPConfigs.StrictResource
+// to fail instead.
+//
+// Parameters:
+//   - ctx: Context used by the resource detectors
+//   - cfgs: Application configurations containing the application and OTLP settings
+//
+// Returns:
+//   - *resource.Resource: The application resource
+//   - error: An error if OTLPConfigs.SemconvVersion is not supported or an
+//     OTLPConfigs.ResourceEnvAttributes mapping is malformed, or any error encountered during
+//     resource detection when OTLPConfigs.StrictResource is enabled
+func NewResource(ctx context.Context, cfgs *configs.Configs) (*resource.Resource, error) {
+	pinned, err := pinnedSchemaURL(cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	envAttrs, err := envAttributes(cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := resource.NewWithAttributes(pinned, serviceAttributes(cfgs)...)
+
+	ctx, cancel := context.WithTimeout(ctx, resourceDetectionTimeout(cfgs))
+	defer cancel()
+
+	// OTEL_RESOURCE_ATTRIBUTES is merged last so that it overrides the detected attributes.
+	opts := []resource.Option{resource.WithTelemetrySDK()}
+	for _, detector := range registeredDetectors() {
+		opts = append(opts, resource.WithDetectors(detector))
+	}
+	if len(envAttrs) > 0 {
+		opts = append(opts, resource.WithAttributes(envAttrs...))
+	}
+	opts = append(opts, resource.WithFromEnv())
+
+	for _, opt := range opts {
+		detected, err := resource.New(ctx, opt)
+		if err != nil {
+			if cfgs.OTLPConfigs.StrictResource {
+				return nil, fmt.Errorf("failed to detect otel resource: %w", err)
+			}
+			logger(cfgs).Warn("otel resource detection failed, continuing with the detected attributes", zap.Error(err))
+		}
+
+		// Detectors failing partially still return the attributes they detected.
+		if detected != nil {
+			res = mergeResources(cfgs, pinned, res, detected)
+		}
+	}
+
+	res = mergeResources(cfgs, pinned, res, resource.NewSchemaless(semconv.ServiceName(serviceName(cfgs))))
+
+	schema := res.SchemaURL()
+	if schema == "" {
+		schema = DefaultSchemaURL
+	}
+	schemaURL.Store(schema)
+
+	return resource.NewWithAttributes(schema, res.Attributes()...), nil
+}
+
+func resourceDetectionTimeout(cfgs *configs.Configs) time.Duration {
+	if cfgs.OTLPConfigs.ResourceDetectionTimeout > 0 {
+		return cfgs.OTLPConfigs.ResourceDetectionTimeout
+	}
+
+	return DefaultResourceDetectionTimeout
+}
+
+// mergeResources merges b into a, resolving schema URL conflicts in favor of the pinned
+// schema URL, when set, instead of failing.
+func mergeResources(cfgs *configs.Configs, pinned string, a, b *resource.Resource) *resource.Resource {
+	merged, err := resource.Merge(a, b)
+	if err == nil {
+		return merged
+	}
+
+	schema := pinned
+	if schema == "" {
+		schema = newestSchemaURL(a.SchemaURL(), b.SchemaURL())
+	}
+
+	logger(cfgs).Warn(
+		"resolved conflicting otel resource schema URLs",
+		zap.String("schema_url", a.SchemaURL()),
+		zap.String("conflicting_schema_url", b.SchemaURL()),
+		zap.String("resolved_schema_url", schema),
+	)
+
+	return resource.NewWithAttributes(schema, merged.Attributes()...)
+}
+
+// newestSchemaURL returns the schema URL with the highest version, the version
+// being the last path segment of the URL (e.g. https://opentelemetry.io/schemas/1.26.0).
+func newestSchemaURL(a, b string) string {
+	va := strings.Split(path.Base(a), ".")
+	vb := strings.Split(path.Base(b), ".")
+
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		na, errA := strconv.Atoi(va[i])
+		nb, errB := strconv.Atoi(vb[i])
+		if errA != nil || errB != nil {
+			break
+		}
+
+		if na != nb {
+			if na > nb {
+				return a
+			}
+			return b
+		}
+	}
+
+	if len(vb) > len(va) {
+		return b
+	}
+	return a
+}
+
+func serviceAttributes(cfgs *configs.Configs) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if cfgs.AppConfigs == nil {
+		return attrs
+	}
+
+	if cfgs.AppConfigs.Namespace != "" {
+		attrs = append(attrs, semconv.ServiceNamespace(cfgs.AppConfigs.Namespace))
+	}
+
+	return attrs
+}
+
+func serviceName(cfgs *configs.Configs) string {
+	if name := strings.TrimSpace(os.Getenv("OTEL_SERVICE_NAME")); name != "" {
+		return name
+	}
+
+	if name := envResourceAttribute(string(semconv.ServiceNameKey)); name != "" {
+		return name
+	}
+
+	if cfgs.AppConfigs != nil && cfgs.AppConfigs.Name != "" {
+		return cfgs.AppConfigs.Name
+	}
+
+	return "unknown_service:" + filepath.Base(os.Args[0])
+}
+
+// envResourceAttribute returns the value of key in the OTEL_RESOURCE_ATTRIBUTES environment
+// variable, a comma-separated list of percent-encoded key=value pairs.
+func envResourceAttribute(key string) string {
+	for kv := range strings.SplitSeq(os.Getenv("OTEL_RESOURCE_ATTRIBUTES"), ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || strings.TrimSpace(k) != key {
+			continue
+		}
+
+		value, err := url.PathUnescape(strings.TrimSpace(v))
+		if err != nil {
+			return ""
+		}
+		return value
+	}
+
+	return ""
+}
+
+// envAttributes returns the attributes described by the OTLPConfigs.ResourceEnvAttributes
+// mappings, written as "ENV=ATTRIBUTE". Unset or empty environment variables are skipped.
+func envAttributes(cfgs *configs.Configs) ([]attribute.KeyValue, error) {
+	attrs := make([]attribute.KeyValue, 0, len(cfgs.OTLPConfigs.ResourceEnvAttributes))
+	for _, mapping := range cfgs.OTLPConfigs.ResourceEnvAttributes {
+		env, key, ok := strings.Cut(mapping, "=")
+		env, key = strings.TrimSpace(env), strings.TrimSpace(key)
+		if !ok || env == "" || key == "" {
+			return nil, fmt.Errorf("invalid resource env attribute %q: expected ENV=ATTRIBUTE", mapping)
+		}
+
+		if value := strings.TrimSpace(os.Getenv(env)); value != "" {
+			attrs = append(attrs, attribute.String(key, value))
+		}
+	}
+
+	return attrs, nil
+}
+
+// resourceHeaders returns the export headers mirroring resource attributes described by the
+// OTLPConfigs.ResourceHeaders mappings, written as "ATTRIBUTE=HEADER", e.g.
+// "service.name=service-name". Attributes missing from the resource are skipped.
+func resourceHeaders(cfgs *configs.Configs, res *resource.Resource) (map[string]string, error) {
+	headers := make(map[string]string, len(cfgs.OTLPConfigs.ResourceHeaders))
+	for _, mapping := range cfgs.OTLPConfigs.ResourceHeaders {
+		key, header, ok := strings.Cut(mapping, "=")
+		key, header = strings.TrimSpace(key), strings.ToLower(strings.TrimSpace(header))
+		if !ok || key == "" || header == "" {
+			return nil, fmt.Errorf("invalid resource header %q: expected ATTRIBUTE=HEADER", mapping)
+		}
+
+		if value, ok := res.Set().Value(attribute.Key(key)); ok {
+			headers[header] = value.Emit()
+		}
+	}
+
+	return headers, nil
+}
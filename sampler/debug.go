@@ -0,0 +1,59 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package sampler
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+)
+
+type debug struct {
+	sampler sdktrace.Sampler
+	logger  *zap.Logger
+}
+
+// NewDebug wraps a sampler so that every sampling decision is logged at debug level with
+// the decision, the trace id, the span name and the description of the deciding sampler.
+// It is meant to troubleshoot sampling configurations and should not be left enabled in production.
+//
+// Parameters:
+//   - sampler: The sampler whose decisions are logged
+//   - logger: The logger the decisions are written to
+//
+// Returns:
+//   - sdktrace.Sampler: The logging sampler
+func NewDebug(sampler sdktrace.Sampler, logger *zap.Logger) sdktrace.Sampler {
+	return &debug{sampler: sampler, logger: logger}
+}
+
+func (s *debug) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := s.sampler.ShouldSample(p)
+
+	if ce := s.logger.Check(zap.DebugLevel, "sampling decision"); ce != nil {
+		ce.Write(
+			zap.String("decision", decisionName(result.Decision)),
+			zap.String("trace_id", p.TraceID.String()),
+			zap.String("span_name", p.Name),
+			zap.String("sampler", s.sampler.Description()),
+		)
+	}
+
+	return result
+}
+
+func (s *debug) Description() string {
+	return s.sampler.Description()
+}
+
+func decisionName(d sdktrace.SamplingDecision) string {
+	switch d {
+	case sdktrace.RecordAndSample:
+		return "sampled"
+	case sdktrace.RecordOnly:
+		return "record_only"
+	default:
+		return "dropped"
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package sampler
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type rateLimited struct {
+	mu       sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+	clock    clock.Clock
+}
+
+// NewRateLimited creates a token-bucket sampler that samples at most spansPerSecond spans
+// per second. The bucket holds up to one second worth of tokens, so short bursts are
+// allowed while the sustained rate is capped. Spans arriving when the bucket is empty are dropped.
+//
+// The sampler is not parent-aware by itself; wrap it with sdktrace.ParentBased (or use New)
+// so that only root spans consume tokens and the whole trace follows the root decision.
+//
+// Parameters:
+//   - spansPerSecond: The maximum sustained number of sampled spans per second
+//
+// Returns:
+//   - sdktrace.Sampler: The rate-limiting sampler
+func NewRateLimited(spansPerSecond float64) sdktrace.Sampler {
+	return NewRateLimitedWithClock(spansPerSecond, clock.Real())
+}
+
+// NewRateLimitedWithClock creates a rate-limiting sampler as NewRateLimited, refilling its
+// bucket according to the given clock. It allows tests to drive the limiter deterministically
+// with a clock.Fake.
+//
+// Parameters:
+//   - spansPerSecond: The maximum sustained number of sampled spans per second
+//   - clk: The clock used to measure the elapsed time between decisions
+//
+// Returns:
+//   - sdktrace.Sampler: The rate-limiting sampler
+func NewRateLimitedWithClock(spansPerSecond float64, clk clock.Clock) sdktrace.Sampler {
+	capacity := math.Max(spansPerSecond, 1)
+
+	return &rateLimited{
+		rate:     spansPerSecond,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     clk.Now(),
+		clock:    clk,
+	}
+}
+
+func (s *rateLimited) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	decision := sdktrace.Drop
+	if s.take() {
+		decision = sdktrace.RecordAndSample
+	}
+
+	return sdktrace.SamplingResult{
+		Decision:   decision,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+func (s *rateLimited) Description() string {
+	return fmt.Sprintf("RateLimited{%g}", s.rate)
+}
+
+func (s *rateLimited) take() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	s.tokens = math.Min(s.capacity, s.tokens+now.Sub(s.last).Seconds()*s.rate)
+	s.last = now
+
+	if s.tokens < 1 {
+		return false
+	}
+
+	s.tokens--
+	return true
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package sampler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goxkit/otel/clock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// sampled reports whether s samples a root span.
+func sampled(s sdktrace.Sampler) bool {
+	return s.ShouldSample(sdktrace.SamplingParameters{}).Decision == sdktrace.RecordAndSample
+}
+
+// countSampled returns how many of n consecutive root spans s samples.
+func countSampled(s sdktrace.Sampler, n int) int {
+	count := 0
+	for range n {
+		if sampled(s) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestRateLimitedBurst(t *testing.T) {
+	s := NewRateLimitedWithClock(10, clock.NewFake(time.Unix(0, 0)))
+
+	if got := countSampled(s, 15); got != 10 {
+		t.Errorf("sampled spans of a burst = %d, want the bucket capacity 10", got)
+	}
+}
+
+func TestRateLimitedRefill(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	s := NewRateLimitedWithClock(10, clk)
+	countSampled(s, 10)
+
+	clk.Advance(50 * time.Millisecond)
+	if sampled(s) {
+		t.Error("sampled after half a token was refilled, want dropped")
+	}
+
+	clk.Advance(50 * time.Millisecond)
+	if got := countSampled(s, 3); got != 1 {
+		t.Errorf("sampled spans after one token was refilled = %d, want 1", got)
+	}
+
+	clk.Advance(300 * time.Millisecond)
+	if got := countSampled(s, 5); got != 3 {
+		t.Errorf("sampled spans after three tokens were refilled = %d, want 3", got)
+	}
+}
+
+func TestRateLimitedOneSecondBoundary(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    float64
+		advance time.Duration
+		want    int
+	}{
+		{name: "just before one second", rate: 10, advance: 999 * time.Millisecond, want: 9},
+		{name: "exactly one second", rate: 10, advance: time.Second, want: 10},
+		{name: "beyond one second capped at the capacity", rate: 10, advance: 5 * time.Second, want: 10},
+		{name: "below one span per second just before the boundary", rate: 0.5, advance: 2*time.Second - time.Millisecond, want: 0},
+		{name: "below one span per second at the boundary", rate: 0.5, advance: 2 * time.Second, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFake(time.Unix(0, 0))
+			s := NewRateLimitedWithClock(tt.rate, clk)
+			countSampled(s, int(tt.rate)+1)
+
+			clk.Advance(tt.advance)
+			if got := countSampled(s, 20); got != tt.want {
+				t.Errorf("sampled spans after %v = %d, want %d", tt.advance, got, tt.want)
+			}
+		})
+	}
+}
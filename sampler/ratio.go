@@ -0,0 +1,114 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package sampler
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Ratio is a trace id ratio based sampler, equivalent to sdktrace.TraceIDRatioBased, whose
+// ratio can be changed at runtime, e.g. to raise sampling during an incident without
+// redeploying. The current ratio is read atomically on each decision.
+type Ratio struct {
+	bits atomic.Uint64
+	seed uint64
+}
+
+// NewRatio creates a Ratio sampler sampling the given fraction of traces. Ratios greater than
+// or equal to 1 sample every trace, ratios lower than or equal to 0 none.
+//
+// Like sdktrace.TraceIDRatioBased, the sampler is not parent-aware by itself; wrap it with
+// sdktrace.ParentBased (or use New) so that the whole trace follows the root decision.
+//
+// Parameters:
+//   - ratio: The fraction of traces to be sampled
+//
+// Returns:
+//   - *Ratio: The ratio sampler
+func NewRatio(ratio float64) *Ratio {
+	s := &Ratio{}
+	s.SetRatio(ratio)
+	return s
+}
+
+// NewSeededRatio creates a Ratio sampler as NewRatio whose decisions are derived from the trace id
+// mixed with seed. Decisions stay deterministic, the same trace id and seed always producing the
+// same decision across runs, while different seeds sample different subsets of trace ids, so
+// that load test harnesses can pin and assert on sampling outcomes. A zero seed behaves as
+// NewRatio, consistently with sdktrace.TraceIDRatioBased.
+//
+// Parameters:
+//   - ratio: The fraction of traces to be sampled
+//   - seed: The seed mixed with the trace ids
+//
+// Returns:
+//   - *Ratio: The ratio sampler
+func NewSeededRatio(ratio float64, seed uint64) *Ratio {
+	s := &Ratio{seed: seed}
+	s.SetRatio(ratio)
+	return s
+}
+
+// SetRatio changes the fraction of traces sampled by the following decisions.
+//
+// Parameters:
+//   - ratio: The fraction of traces to be sampled, clamped to [0, 1]
+func (s *Ratio) SetRatio(ratio float64) {
+	if math.IsNaN(ratio) {
+		ratio = 0
+	}
+	s.bits.Store(math.Float64bits(min(max(ratio, 0), 1)))
+}
+
+// Ratio returns the current fraction of sampled traces.
+func (s *Ratio) Ratio() float64 {
+	return math.Float64frombits(s.bits.Load())
+}
+
+// ShouldSample samples the span when the high 63 bits of the lower half of its trace id (bytes
+// 8 to 15), as a fraction of their range, are below the current ratio, as
+// sdktrace.TraceIDRatioBased does. When seeded, the lower half is mixed with the seed first.
+func (s *Ratio) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := sdktrace.SamplingResult{
+		Decision:   sdktrace.Drop,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+
+	ratio := s.Ratio()
+	upperBound := uint64(ratio * (1 << 63))
+	x := binary.BigEndian.Uint64(p.TraceID[8:16])
+	if s.seed != 0 {
+		x = mix(x ^ s.seed)
+	}
+	x >>= 1
+	if ratio >= 1 || x < upperBound {
+		result.Decision = sdktrace.RecordAndSample
+	}
+
+	return result
+}
+
+func (s *Ratio) Description() string {
+	if s.seed != 0 {
+		return fmt.Sprintf("Ratio{%g,seed=%d}", s.Ratio(), s.seed)
+	}
+	return fmt.Sprintf("Ratio{%g}", s.Ratio())
+}
+
+// mix is the splitmix64 finalizer, spreading every bit of x over the result.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
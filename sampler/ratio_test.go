@@ -0,0 +1,215 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package sampler
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/goxkit/configs"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceIDs returns n pseudo-random trace ids, the same ones on every run.
+func traceIDs(n int) []trace.TraceID {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	ids := make([]trace.TraceID, n)
+	for i := range ids {
+		for j := range ids[i] {
+			ids[i][j] = byte(r.Uint32())
+		}
+	}
+	return ids
+}
+
+// sampledTraceID reports whether s samples a root span of the given trace id.
+func sampledTraceID(s sdktrace.Sampler, id trace.TraceID) bool {
+	p := sdktrace.SamplingParameters{ParentContext: context.Background(), TraceID: id}
+	return s.ShouldSample(p).Decision == sdktrace.RecordAndSample
+}
+
+func TestRatioSetRatioClamps(t *testing.T) {
+	tests := []struct {
+		name  string
+		ratio float64
+		want  float64
+	}{
+		{name: "below zero", ratio: -0.5, want: 0},
+		{name: "negative infinity", ratio: math.Inf(-1), want: 0},
+		{name: "above one", ratio: 1.5, want: 1},
+		{name: "positive infinity", ratio: math.Inf(1), want: 1},
+		{name: "NaN", ratio: math.NaN(), want: 0},
+		{name: "within range", ratio: 0.25, want: 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewRatio(0.5)
+			s.SetRatio(tt.ratio)
+			if got := s.Ratio(); got != tt.want {
+				t.Errorf("Ratio() after SetRatio(%v) = %v, want %v", tt.ratio, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatioDecisionsAtBounds(t *testing.T) {
+	ids := append(traceIDs(1000), trace.TraceID{}, trace.TraceID{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	})
+
+	for _, seed := range []uint64{0, 42} {
+		none := NewSeededRatio(0, seed)
+		all := NewSeededRatio(1, seed)
+		for _, id := range ids {
+			if sampledTraceID(none, id) {
+				t.Errorf("ratio 0 with seed %d sampled trace %s, want dropped", seed, id)
+			}
+			if !sampledTraceID(all, id) {
+				t.Errorf("ratio 1 with seed %d dropped trace %s, want sampled", seed, id)
+			}
+		}
+	}
+}
+
+func TestRatioMatchesTraceIDRatioBased(t *testing.T) {
+	for _, ratio := range []float64{0.1, 0.5, 0.9} {
+		s := NewRatio(ratio)
+		sdk := sdktrace.TraceIDRatioBased(ratio)
+		for _, id := range traceIDs(1000) {
+			if got, want := sampledTraceID(s, id), sampledTraceID(sdk, id); got != want {
+				t.Fatalf("ratio %v sampled trace %s = %v, want %v as sdktrace.TraceIDRatioBased", ratio, id, got, want)
+			}
+		}
+	}
+}
+
+func TestRatioConcurrentSetRatio(t *testing.T) {
+	s := NewRatio(0.5)
+	ids := traceIDs(100)
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 1000 {
+				s.SetRatio(float64((i+j)%3) / 2)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := range 1000 {
+				sampledTraceID(s, ids[j%len(ids)])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := s.Ratio(); got < 0 || got > 1 {
+		t.Errorf("Ratio() = %v, want a value within [0, 1]", got)
+	}
+}
+
+func TestRatioChildrenKeepParentDecision(t *testing.T) {
+	ratio := NewRatio(1)
+	cfgs := &configs.Configs{OTLPConfigs: &configs.OTLPConfigs{}}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSampler(New(cfgs, ratio)))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+	tracer := tp.Tracer("test")
+
+	sampledCtx, sampledRoot := tracer.Start(context.Background(), "sampled root")
+	defer sampledRoot.End()
+	if !sampledRoot.SpanContext().IsSampled() {
+		t.Fatal("root span started with ratio 1 is not sampled")
+	}
+
+	ratio.SetRatio(0)
+
+	droppedCtx, droppedRoot := tracer.Start(context.Background(), "dropped root")
+	defer droppedRoot.End()
+	if droppedRoot.SpanContext().IsSampled() {
+		t.Error("root span started with ratio 0 is sampled, want dropped")
+	}
+
+	_, child := tracer.Start(sampledCtx, "child")
+	defer child.End()
+	if !child.SpanContext().IsSampled() {
+		t.Error("child of a sampled root started after SetRatio(0) is dropped, want sampled")
+	}
+
+	ratio.SetRatio(1)
+
+	_, child = tracer.Start(droppedCtx, "child")
+	defer child.End()
+	if child.SpanContext().IsSampled() {
+		t.Error("child of a dropped root started after SetRatio(1) is sampled, want dropped")
+	}
+}
+
+func TestSeededRatioReproducible(t *testing.T) {
+	ids := traceIDs(1000)
+
+	first := NewSeededRatio(0.5, 42)
+	second := NewSeededRatio(0.5, 42)
+	for _, id := range ids {
+		want := sampledTraceID(first, id)
+		for range 3 {
+			if got := sampledTraceID(first, id); got != want {
+				t.Fatalf("seed 42 decision for trace %s changed between calls", id)
+			}
+		}
+		if got := sampledTraceID(second, id); got != want {
+			t.Fatalf("seed 42 decision for trace %s = %v with another sampler, want %v", id, got, want)
+		}
+	}
+}
+
+func TestSeededRatioSeedsSampleDifferentSets(t *testing.T) {
+	ids := traceIDs(1000)
+
+	sampledSet := func(s sdktrace.Sampler) map[trace.TraceID]bool {
+		set := map[trace.TraceID]bool{}
+		for _, id := range ids {
+			if sampledTraceID(s, id) {
+				set[id] = true
+			}
+		}
+		return set
+	}
+
+	sets := []map[trace.TraceID]bool{
+		sampledSet(NewSeededRatio(0.5, 0)),
+		sampledSet(NewSeededRatio(0.5, 1)),
+		sampledSet(NewSeededRatio(0.5, 2)),
+	}
+	for i, set := range sets {
+		// Each seed still samples about half of the traces.
+		if len(set) < 400 || len(set) > 600 {
+			t.Errorf("seed set %d sampled %d of %d traces, want about half", i, len(set), len(ids))
+		}
+	}
+
+	for i := range sets {
+		for j := i + 1; j < len(sets); j++ {
+			common := 0
+			for id := range sets[i] {
+				if sets[j][id] {
+					common++
+				}
+			}
+			// Independent halves share about a quarter of the traces, identical ones all of them.
+			if common > 350 {
+				t.Errorf("seed sets %d and %d share %d sampled traces, want different sets", i, j, common)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package sampler
+
+import (
+	"fmt"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type recordDropped struct {
+	sampler sdktrace.Sampler
+}
+
+// NewRecordDropped wraps a sampler so that the spans it drops are recorded without being sampled
+// (sdktrace.RecordOnly) instead of being discarded. They are still not exported by the batch span
+// processor, but span processors receive them once ended and can decide after the fact to export
+// some of them, e.g. the ones ending in error (see processor.NewErrorBiased).
+//
+// Recording every span costs CPU and memory compared to dropping them at start.
+//
+// Parameters:
+//   - sampler: The sampler whose drop decisions are turned into record-only decisions
+//
+// Returns:
+//   - sdktrace.Sampler: The wrapping sampler
+func NewRecordDropped(sampler sdktrace.Sampler) sdktrace.Sampler {
+	return &recordDropped{sampler: sampler}
+}
+
+func (s *recordDropped) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := s.sampler.ShouldSample(p)
+	if result.Decision == sdktrace.Drop {
+		result.Decision = sdktrace.RecordOnly
+	}
+
+	return result
+}
+
+func (s *recordDropped) Description() string {
+	return fmt.Sprintf("RecordDropped{%s}", s.sampler.Description())
+}
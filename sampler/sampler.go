@@ -0,0 +1,105 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package sampler provides OpenTelemetry trace samplers used by Goxkit applications.
+// The samplers in this package are designed to compose with the samplers shipped by the
+// OpenTelemetry SDK, so that absolute volume limits can be layered on top of ratio sampling.
+package sampler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goxkit/configs"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New builds the sampler described by the application configurations on top of the given
+// base sampler (typically sdktrace.TraceIDRatioBased). The resulting sampler is parent-based:
+// root spans are decided by the base sampler and, when OTLPConfigs.MaxSpansPerSecond is set,
+// by a rate limiter, while child spans always follow the decision of their parent so that
+// traces are never partially sampled.
+//
+// When OTLPConfigs.AlwaysSampleErrors is enabled, dropped spans are recorded instead so that
+// error traces can be exported after the fact (see NewRecordDropped).
+//
+// When OTLPConfigs.DebugSampling is enabled, every decision is logged at debug level (see NewDebug).
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//   - base: The sampler used to decide root spans, defaults to AlwaysSample when nil
+//
+// Returns:
+//   - sdktrace.Sampler: The configured sampler
+func New(cfgs *configs.Configs, base sdktrace.Sampler) sdktrace.Sampler {
+	if base == nil {
+		base = sdktrace.AlwaysSample()
+	}
+
+	root := base
+	if cfgs.OTLPConfigs.MaxSpansPerSecond > 0 {
+		root = All(base, NewRateLimited(cfgs.OTLPConfigs.MaxSpansPerSecond))
+	}
+
+	sampler := sdktrace.ParentBased(root)
+	if cfgs.OTLPConfigs.AlwaysSampleErrors {
+		sampler = NewRecordDropped(sampler)
+	}
+
+	if cfgs.OTLPConfigs.DebugSampling && cfgs.Logger != nil {
+		return NewDebug(sampler, cfgs.Logger)
+	}
+
+	return sampler
+}
+
+type all struct {
+	samplers []sdktrace.Sampler
+}
+
+// All returns a sampler that records and samples a span only when every given sampler does.
+// Samplers are consulted in order and evaluation stops at the first drop decision, which
+// means stateful samplers (such as the rate limiter) only spend budget on spans that
+// survived the samplers placed before them.
+//
+// Parameters:
+//   - samplers: The samplers to be combined
+//
+// Returns:
+//   - sdktrace.Sampler: The combined sampler
+func All(samplers ...sdktrace.Sampler) sdktrace.Sampler {
+	return &all{samplers: samplers}
+}
+
+func (s *all) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := sdktrace.SamplingResult{
+		Decision:   sdktrace.RecordAndSample,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+
+	for _, sampler := range s.samplers {
+		r := sampler.ShouldSample(p)
+		if r.Decision == sdktrace.Drop {
+			return r
+		}
+
+		result.Attributes = append(result.Attributes, r.Attributes...)
+		result.Tracestate = r.Tracestate
+		if r.Decision == sdktrace.RecordOnly {
+			result.Decision = sdktrace.RecordOnly
+		}
+	}
+
+	return result
+}
+
+func (s *all) Description() string {
+	descriptions := make([]string, 0, len(s.samplers))
+	for _, sampler := range s.samplers {
+		descriptions = append(descriptions, sampler.Description())
+	}
+
+	return fmt.Sprintf("All{%s}", strings.Join(descriptions, ","))
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the instrumentation scope name used by the tracer, meter and
+// logger created by this package.
+const InstrumentationName = "github.com/goxkit/otel"
+
+// Tracer returns the package tracer from the global TracerProvider, carrying the configured schema URL.
+func Tracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer(InstrumentationName, trace.WithSchemaURL(SchemaURL()))
+}
+
+// Meter returns the package meter from the global MeterProvider, carrying the configured schema URL.
+func Meter() metric.Meter {
+	return otel.GetMeterProvider().Meter(InstrumentationName, metric.WithSchemaURL(SchemaURL()))
+}
+
+// Logger returns the package logger from the global LoggerProvider, carrying the configured schema URL.
+func Logger() log.Logger {
+	return global.GetLoggerProvider().Logger(InstrumentationName, log.WithSchemaURL(SchemaURL()))
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goxkit/configs"
+)
+
+// semconvSchemaURLPrefix is the prefix of the semantic-conventions schema URLs, followed by the version.
+const semconvSchemaURLPrefix = "https://opentelemetry.io/schemas/"
+
+// SupportedSemconvVersions are the semantic-conventions versions OTLPConfigs.SemconvVersion can pin,
+// up to the bundled semconv/v1.26.0 package the attribute keys come from. The keys emitted by this
+// module (service, telemetry SDK, HTTP and code attributes) are identical across them.
+var SupportedSemconvVersions = []string{
+	"1.21.0", "1.22.0", "1.23.0", "1.23.1", "1.24.0", "1.25.0", "1.26.0",
+}
+
+// pinnedSchemaURL returns the schema URL the application pins: OTLPConfigs.SchemaURL, or the schema
+// URL of OTLPConfigs.SemconvVersion, or an empty string when none is pinned. SemconvVersion is
+// validated even when SchemaURL takes precedence over it.
+func pinnedSchemaURL(cfgs *configs.Configs) (string, error) {
+	version := strings.TrimPrefix(strings.TrimSpace(cfgs.OTLPConfigs.SemconvVersion), "v")
+	if version != "" && !slices.Contains(SupportedSemconvVersions, version) {
+		return "", fmt.Errorf(
+			"unsupported otel semconv version %q: expected one of %s",
+			cfgs.OTLPConfigs.SemconvVersion, strings.Join(SupportedSemconvVersions, ", "),
+		)
+	}
+
+	switch {
+	case cfgs.OTLPConfigs.SchemaURL != "":
+		return cfgs.OTLPConfigs.SchemaURL, nil
+	case version != "":
+		return semconvSchemaURLPrefix + version, nil
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"testing"
+
+	"github.com/goxkit/configs"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestSupportedSemconvVersionsEndAtBundledVersion(t *testing.T) {
+	newest := SupportedSemconvVersions[len(SupportedSemconvVersions)-1]
+	if got := semconvSchemaURLPrefix + newest; got != semconv.SchemaURL {
+		t.Errorf("schema URL of the newest supported version = %q, want the bundled %q", got, semconv.SchemaURL)
+	}
+}
+
+func TestPinnedSchemaURL(t *testing.T) {
+	const customURL = "https://example.com/schemas/1.0.0"
+
+	tests := []struct {
+		name      string
+		schemaURL string
+		version   string
+		want      string
+		wantErr   bool
+	}{
+		{name: "nothing pinned", want: ""},
+		{name: "schema URL", schemaURL: customURL, want: customURL},
+		{name: "supported version", version: "1.24.0", want: semconvSchemaURLPrefix + "1.24.0"},
+		{name: "version with v prefix and spaces", version: " v1.26.0 ", want: semconv.SchemaURL},
+		{name: "version above the bundled one", version: "1.27.0", wantErr: true},
+		{name: "unknown version", version: "2.0", wantErr: true},
+		{name: "schema URL with supported version", schemaURL: customURL, version: "1.24.0", want: customURL},
+		{name: "schema URL with unsupported version", schemaURL: customURL, version: "1.28.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfgs := &configs.Configs{OTLPConfigs: &configs.OTLPConfigs{
+				SchemaURL:      tt.schemaURL,
+				SemconvVersion: tt.version,
+			}}
+
+			got, err := pinnedSchemaURL(cfgs)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("pinnedSchemaURL() = %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("pinnedSchemaURL() error = %v, want nil", err)
+			}
+			if got != tt.want {
+				t.Errorf("pinnedSchemaURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,745 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/clock"
+	"github.com/goxkit/otel/exporter"
+	"github.com/goxkit/otel/middleware"
+	"github.com/goxkit/otel/otlpgrpc"
+	"github.com/goxkit/otel/processor"
+	"github.com/goxkit/otel/sampler"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Signal names of OTLPConfigs.ShutdownOrder.
+const (
+	SignalTraces  = "traces"
+	SignalMetrics = "metrics"
+	SignalLogs    = "logs"
+)
+
+// defaultShutdownOrder shuts the tracer provider down first, then the meter provider, and the
+// logger provider last so that the records logged meanwhile are still exported.
+var defaultShutdownOrder = []string{SignalTraces, SignalMetrics, SignalLogs}
+
+// Provider holds the OpenTelemetry providers created by Setup together with the
+// gRPC connections they export through.
+type Provider struct {
+	TracerProvider *sdktrace.TracerProvider
+	MeterProvider  *sdkmetric.MeterProvider
+	LoggerProvider *sdklog.LoggerProvider
+
+	cfgs         *configs.Configs
+	connsMu      sync.Mutex
+	conns        []*grpc.ClientConn
+	pools        map[string][]*grpc.ClientConn
+	rotateMu     sync.Mutex
+	monitor      *otlpgrpc.ConnectionMonitor
+	spans        *exporter.Swappable
+	metrics      *exporter.SwappableMetric
+	logs         *exporter.SwappableLog
+	spanExporter *exporter.Observed
+	oversized    *exporter.SizeLimited
+	exports      sdktrace.SpanExporter
+	drain        *exporter.Abandonable
+	endedSpans   *endedSpanCounter
+	batch        *processor.Batch
+	ratio        *sampler.Ratio
+	instruments  instruments
+	resource     *resource.Resource
+	headers      map[string]string
+	order        []string
+	started      time.Time
+}
+
+// Setup creates the resource, the OTLP gRPC connections and the tracer, meter and logger providers
+// described by the application configurations, and registers them, together with the configured
+// propagators and SDK logger, as the OpenTelemetry globals. An exporter that can't be created
+// discards its signal with a warning, unless OTLPConfigs.FailOnInitError is enabled. The options
+// are described in the Configuration Options section of the README.
+//
+// Parameters:
+//   - ctx: Context used during setup
+//   - cfgs: Application configurations containing the application and OTLP settings
+//
+// Returns:
+//   - *Provider: The configured providers, to be shut down before the application exits
+//   - error: Any error encountered during setup
+func Setup(ctx context.Context, cfgs *configs.Configs) (*Provider, error) {
+	if cfgs.Logger != nil && !cfgs.OTLPConfigs.SkipGlobalRegistration {
+		sdkLogger, err := newSDKLogger(cfgs.Logger, cfgs.OTLPConfigs.SDKLogLevel)
+		if err != nil {
+			return nil, err
+		}
+		setSDKLogger(sdkLogger)
+	}
+
+	unit, err := validateDurationUnit(cfgs.OTLPConfigs.DurationUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	spanKind, err := parseSpanKind(cfgs.OTLPConfigs.DefaultSpanKind)
+	if err != nil {
+		return nil, err
+	}
+
+	propagator, err := NewPropagator(cfgs.OTLPConfigs.Propagators...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := NewResource(ctx, cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfgs.OTLPConfigs.ResourceBaggageAttributes) > 0 {
+		if !slices.Contains(propagator.Fields(), "baggage") {
+			return nil, fmt.Errorf("invalid resource baggage attributes: the baggage propagator is not enabled")
+		}
+
+		propagator, err = WithResourceBaggage(propagator, res, attributeKeys(cfgs.OTLPConfigs.ResourceBaggageAttributes)...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	headers, err := resourceHeaders(cfgs, res)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := parseShutdownOrder(cfgs.OTLPConfigs.ShutdownOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		cfgs:     cfgs,
+		monitor:  otlpgrpc.NewConnectionMonitor(),
+		resource: res,
+		headers:  headers,
+		order:    order,
+		started:  time.Now(),
+	}
+
+	spanExporter, err := p.newSpanExporter(ctx)
+	if err != nil {
+		if err := p.initError(SignalTraces, err); err != nil {
+			_ = p.Shutdown(ctx)
+			return nil, err
+		}
+		spanExporter = exporter.NewDiscard()
+	}
+	p.spans = exporter.NewSwappable(spanExporter)
+	spanExporter = p.spans
+
+	if cfgs.OTLPConfigs.ExporterHTTPFallbackEndpoint != "" {
+		fallbackExporter, err := NewHTTPFallbackSpanExporter(ctx, cfgs)
+		if err == nil {
+			spanExporter = exporter.NewFallback(spanExporter, fallbackExporter, clock.Real())
+		} else if err := p.initError(SignalTraces, err); err != nil {
+			_ = p.Shutdown(ctx)
+			return nil, err
+		}
+	}
+
+	if len(cfgs.OTLPConfigs.TracesFanoutEndpoints) > 0 {
+		exporters := []sdktrace.SpanExporter{spanExporter}
+		for _, spec := range cfgs.OTLPConfigs.TracesFanoutEndpoints {
+			fanoutExporter, err := p.newFanoutSpanExporter(ctx, spec)
+			if err != nil {
+				if err := p.initError(SignalTraces, err); err != nil {
+					_ = p.Shutdown(ctx)
+					return nil, err
+				}
+				continue
+			}
+			exporters = append(exporters, fanoutExporter)
+		}
+		spanExporter = exporter.NewFanout(exporters...)
+	}
+	p.oversized, err = exporter.NewSizeLimited(spanExporter, cfgs.OTLPConfigs.MaxSpanSize, cfgs.OTLPConfigs.OversizedSpanAction)
+	if err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+	p.drain = exporter.NewAbandonable(p.oversized)
+	p.spanExporter = exporter.NewObserved(p.drain)
+	p.endedSpans = &endedSpanCounter{}
+	p.exports = exporter.NewConcurrent(p.spanExporter, cfgs.OTLPConfigs.MaxConcurrentExports, exportTimeout(cfgs))
+	p.ratio = sampler.NewSeededRatio(1, cfgs.OTLPConfigs.SamplingSeed)
+	p.TracerProvider, p.batch, err = newTracerProvider(cfgs, p.exports, res, p.ratio)
+	if err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+	p.TracerProvider.RegisterSpanProcessor(p.endedSpans)
+
+	metricExporter, err := p.newMetricExporter(ctx)
+	if err != nil {
+		if err := p.initError(SignalMetrics, err); err != nil {
+			_ = p.Shutdown(ctx)
+			return nil, err
+		}
+		metricExporter = exporter.NewDiscardMetric()
+	}
+
+	p.metrics = exporter.NewSwappableMetric(metricExporter)
+	p.MeterProvider, err = newMeterProvider(ctx, cfgs, p.metrics, res)
+	if err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+
+	if err := registerSpanQueueMetrics(p.MeterProvider, p.batch); err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+
+	if err := registerConnectionMetrics(p.MeterProvider, p.monitor); err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+
+	if err := registerSpanExportMetrics(p.MeterProvider, p.spanExporter, p.oversized); err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+
+	logExporter, err := p.newLogExporter(ctx)
+	if err != nil {
+		if err := p.initError(SignalLogs, err); err != nil {
+			_ = p.Shutdown(ctx)
+			return nil, err
+		}
+		logExporter = exporter.NewDiscardLog()
+	}
+
+	p.logs = exporter.NewSwappableLog(logExporter)
+	p.LoggerProvider, err = newLoggerProvider(cfgs, p.logs, res)
+	if err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, err
+	}
+
+	if !cfgs.OTLPConfigs.SkipGlobalRegistration {
+		defaultSpanTimeout.Store(int64(cfgs.OTLPConfigs.SpanTimeout))
+		durationUnit.Store(unit)
+		defaultSpanKind.Store(int64(spanKind))
+		if len(cfgs.OTLPConfigs.IgnoredPaths) > 0 {
+			middleware.SetIgnoredPaths(cfgs.OTLPConfigs.IgnoredPaths)
+		}
+		otel.SetTracerProvider(p.TracerProvider)
+		otel.SetMeterProvider(p.MeterProvider)
+		global.SetLoggerProvider(p.LoggerProvider)
+		otel.SetTextMapPropagator(propagator)
+	}
+
+	if cfgs.OTLPConfigs.StartupSpan {
+		p.startupSpan()
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), exportTimeout(cfgs))
+			defer cancel()
+
+			if err := p.TracerProvider.ForceFlush(ctx); err != nil {
+				logger(cfgs).Warn("failed to flush the startup span", zap.Error(err))
+			}
+		}()
+	}
+
+	return p, nil
+}
+
+// initError returns err, the failure to create an exporter of signal, when
+// OTLPConfigs.FailOnInitError is enabled or when err is a configuration error, such as
+// credentials configured without TLS, that a discarded signal would only hide. Otherwise it
+// logs err and returns nil, the caller then going on without the exporter.
+func (p *Provider) initError(signal string, err error) error {
+	if p.cfgs.OTLPConfigs.FailOnInitError || errors.Is(err, otlpgrpc.ErrInsecureCredentials) {
+		return err
+	}
+
+	logger(p.cfgs).Warn("failed to create the otel exporter, the signal is not exported",
+		zap.String("signal", signal), zap.Error(err))
+
+	return nil
+}
+
+// newSpanExporter creates the span exporter of the OTLPConfigs.Exporter format and
+// OTLPConfigs.TracesProtocol transport.
+func (p *Provider) newSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	kind, err := spanExporterKind(p.cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind == ExporterJaeger {
+		return NewJaegerSpanExporter(p.cfgs)
+	}
+
+	if kind == ExporterFile {
+		return NewFileSpanExporter(ctx, p.cfgs)
+	}
+
+	protocol, err := signalProtocol(p.cfgs.OTLPConfigs.TracesProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	if protocol == ProtocolHTTP {
+		return NewHTTPSpanExporter(ctx, p.cfgs)
+	}
+
+	conns, err := p.grpcConns(signalEndpoint(p.cfgs, p.cfgs.OTLPConfigs.TracesEndpoint), p.cfgs.OTLPConfigs.ExporterConnectionPoolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	spanExporter, err := NewSpanExporter(ctx, conns, SpanExportRetryOption(p.cfgs))
+	if err != nil {
+		return nil, err
+	}
+
+	return exporter.NewRetryInfo(spanExporter, clock.Real()), nil
+}
+
+// newFanoutSpanExporter creates the OTLP/gRPC span exporter of an OTLPConfigs.TracesFanoutEndpoints
+// spec (see otlpgrpc.ParseEndpointSpec), on its own connection with its own headers. The TLS setting
+// follows the endpoint scheme, or OTLPConfigs.ExporterTLSEnabled when it has none.
+func (p *Provider) newFanoutSpanExporter(ctx context.Context, spec string) (sdktrace.SpanExporter, error) {
+	endpoint, headers := otlpgrpc.ParseEndpointSpec(spec)
+	if endpoint == "" {
+		return nil, fmt.Errorf("invalid traces fan-out endpoint %q: the endpoint is empty", spec)
+	}
+
+	opts := otlpgrpc.NewOptions(p.cfgs)
+	if err := otlpgrpc.LoadCredentials(p.cfgs, &opts); err != nil {
+		return nil, err
+	}
+	opts.ConnectionPoolSize = 1
+	opts.Monitor = p.monitor
+	opts.Headers = headers
+	opts.ResourceHeaders = p.headers
+	target, tls, implied := otlpgrpc.NormalizeEndpoint(endpoint)
+	opts.Endpoint = target
+	opts.TLSEnabled = p.cfgs.OTLPConfigs.ExporterTLSEnabled
+	if implied {
+		opts.TLSEnabled = tls
+	}
+
+	conn, err := otlpgrpc.NewExporterGRPCClientWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	p.conns = append(p.conns, conn)
+
+	spanExporter, err := NewSpanExporter(ctx, []*grpc.ClientConn{conn}, SpanExportRetryOption(p.cfgs))
+	if err != nil {
+		return nil, err
+	}
+
+	return exporter.NewRetryInfo(spanExporter, clock.Real()), nil
+}
+
+// newMetricExporter creates the metric exporter of the OTLPConfigs.MetricsProtocol transport.
+func (p *Provider) newMetricExporter(ctx context.Context) (sdkmetric.Exporter, error) {
+	protocol, err := signalProtocol(p.cfgs.OTLPConfigs.MetricsProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	if protocol == ProtocolHTTP {
+		return NewHTTPMetricExporter(ctx, p.cfgs)
+	}
+
+	conns, err := p.grpcConns(signalEndpoint(p.cfgs, p.cfgs.OTLPConfigs.MetricsEndpoint), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithGRPCConn(conns[0]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp metric exporter: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// newLogExporter creates the log exporter, sending log records over gRPC to OTLPConfigs.Endpoint.
+func (p *Provider) newLogExporter(ctx context.Context) (sdklog.Exporter, error) {
+	conns, err := p.grpcConns(p.cfgs.OTLPConfigs.Endpoint, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLogExporter(ctx, conns[0])
+}
+
+// grpcConns returns the gRPC connections to endpoint, creating a pool of size connections
+// on first use, so that signals exported over gRPC to the same endpoint share them.
+func (p *Provider) grpcConns(endpoint string, size int) ([]*grpc.ClientConn, error) {
+	if conns, ok := p.pools[endpoint]; ok {
+		return conns, nil
+	}
+
+	opts := otlpgrpc.NewOptions(p.cfgs)
+	if err := otlpgrpc.LoadCredentials(p.cfgs, &opts); err != nil {
+		return nil, err
+	}
+	opts.ConnectionPoolSize = size
+	opts.Monitor = p.monitor
+	opts.ResourceHeaders = p.headers
+	if endpoint != p.cfgs.OTLPConfigs.Endpoint {
+		target, tls, implied := otlpgrpc.NormalizeEndpoint(endpoint)
+		opts.Endpoint = target
+		if implied {
+			opts.TLSEnabled = tls
+		}
+	}
+
+	conns, err := otlpgrpc.NewExporterGRPCClientPoolWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pools == nil {
+		p.pools = map[string][]*grpc.ClientConn{}
+	}
+	p.pools[endpoint] = conns
+	p.conns = append(p.conns, conns...)
+
+	return conns, nil
+}
+
+// SetSamplingRatio changes at runtime the fraction of root spans sampled by the tracer provider,
+// e.g. to raise sampling during an incident without redeploying. Every trace is sampled until
+// it is called. Decisions are deterministic per trace id and, when OTLPConfigs.SamplingSeed is
+// set, per seed (see sampler.NewSeededRatio). It only affects the decisions made afterwards for
+// new root spans: child spans keep following the decision of their parent, so traces in flight
+// are never partially sampled, and OTLPConfigs.MaxSpansPerSecond still caps the sampled volume.
+//
+// Parameters:
+//   - ratio: The fraction of root spans to be sampled, clamped to [0, 1]
+func (p *Provider) SetSamplingRatio(ratio float64) {
+	p.ratio.SetRatio(ratio)
+}
+
+// Pause stops exporting spans at runtime, e.g. during a planned collector maintenance window,
+// avoiding a flood of failed exports. Spans keep being buffered within the span queue bounds, and
+// the ones ending while it is full are dropped and counted by otel.sdk.processor.span.dropped.
+// Metrics and logs keep being exported, while FlushAndWait reports processor.ErrPaused.
+func (p *Provider) Pause() {
+	p.batch.Pause()
+}
+
+// Resume resumes exporting spans after Pause, exporting the buffered spans right away.
+func (p *Provider) Resume() {
+	p.batch.Resume()
+}
+
+// RotateConnection replaces the gRPC connections the spans, metrics and logs are exported through
+// by new ones dialed from cfgs, e.g. so that the collector endpoint is resolved again after it
+// moved. New export calls go through the new connections right away, while the calls in flight
+// on the previous ones are given the export timeout to return before the previous connections
+// are closed, so that no buffered telemetry is lost. The connections of
+// OTLPConfigs.TracesFanoutEndpoints are kept, as is the rest of the pipeline, only the connection
+// settings of cfgs being used.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings, typically the ones given to Setup
+//
+// Returns:
+//   - error: An error if the new connections can't be created, in which case the current ones are
+//     kept, or if in-flight export calls didn't return in time
+func (p *Provider) RotateConnection(cfgs *configs.Configs) error {
+	p.rotateMu.Lock()
+	defer p.rotateMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout(cfgs))
+	defer cancel()
+
+	next := &Provider{cfgs: cfgs, monitor: p.monitor, headers: p.headers}
+	spanExporter, err := next.newSpanExporter(ctx)
+	if err != nil {
+		return errors.Join(err, next.closeConns())
+	}
+	metricExporter, err := next.newMetricExporter(ctx)
+	if err != nil {
+		return errors.Join(err, next.closeConns())
+	}
+	logExporter, err := next.newLogExporter(ctx)
+	if err != nil {
+		return errors.Join(err, next.closeConns())
+	}
+
+	var errs []error
+	if err := p.spans.Swap(ctx, spanExporter); err != nil {
+		errs = append(errs, fmt.Errorf("failed to swap span exporter: %w", err))
+	}
+	if err := p.metrics.Swap(ctx, metricExporter); err != nil {
+		errs = append(errs, fmt.Errorf("failed to swap metric exporter: %w", err))
+	}
+	if err := p.logs.Swap(ctx, logExporter); err != nil {
+		errs = append(errs, fmt.Errorf("failed to swap log exporter: %w", err))
+	}
+
+	p.connsMu.Lock()
+	previous := p.pools
+	conns := slices.DeleteFunc(slices.Clone(p.conns), func(conn *grpc.ClientConn) bool {
+		for _, pool := range previous {
+			if slices.Contains(pool, conn) {
+				return true
+			}
+		}
+		return false
+	})
+	p.conns = append(conns, next.conns...)
+	p.pools = next.pools
+	p.connsMu.Unlock()
+
+	for _, pool := range previous {
+		for _, conn := range pool {
+			if err := conn.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to close otel exporter gRPC conn: %w", err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeConns closes the gRPC connections of the provider.
+func (p *Provider) closeConns() error {
+	p.connsMu.Lock()
+	conns := p.conns
+	p.connsMu.Unlock()
+
+	var errs []error
+	for _, conn := range conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close otel exporter gRPC conn: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// FlushAndWait exports the telemetry recorded so far and blocks until the export calls have
+// returned, not merely until the data is queued, so that a test can reliably assert that a span
+// reached a test collector. It is intended for tests, not for hot paths. While span export is
+// paused, the spans are not exported and the returned error wraps processor.ErrPaused.
+//
+// Parameters:
+//   - ctx: Context bounding the flush
+//
+// Returns:
+//   - error: The errors encountered while flushing, if any
+func (p *Provider) FlushAndWait(ctx context.Context) error {
+	var errs []error
+
+	if err := p.TracerProvider.ForceFlush(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush tracer provider: %w", err))
+	}
+	if err := exporter.Wait(ctx, p.exports); err != nil {
+		errs = append(errs, fmt.Errorf("failed to wait for span exports: %w", err))
+	}
+
+	if err := p.MeterProvider.ForceFlush(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush meter provider: %w", err))
+	}
+
+	if err := p.LoggerProvider.ForceFlush(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush logger provider: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Shutdown flushes and shuts down the providers and closes every gRPC connection of the pool.
+// All steps are attempted even if an earlier one fails, and their errors are returned joined.
+//
+// The providers are shut down one after the other in the OTLPConfigs.ShutdownOrder order, a list
+// of the "traces", "metrics" and "logs" signals, the omitted ones following in the default
+// traces, metrics, logs order. Shutting logs down last exports the records logged while the other
+// providers shut down, while shutting them down first suits logs depending on a span exporter.
+//
+// The span queue drain is bounded by the ctx deadline: when it expires (e.g. the collector is
+// down), the pending exports are abandoned and the number of dropped spans is logged, so that
+// the process can exit within its termination grace period.
+//
+// When OTLPConfigs.LogShutdownStats is enabled, a summary of the telemetry volume of the process
+// lifetime is logged at info level once the providers are shut down: the spans exported, dropped
+// because the queue was full and failed to export, the bytes sent over the gRPC connections
+// (for every signal, after compression) and the uptime of the provider.
+//
+// Parameters:
+//   - ctx: Context bounding the shutdown
+//
+// Returns:
+//   - error: The errors encountered while shutting down, if any
+func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs []error
+
+	order := p.order
+	if order == nil {
+		order = defaultShutdownOrder
+	}
+	for _, signal := range order {
+		if err := p.shutdownSignal(ctx, signal); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := p.closeConns(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if p.cfgs.OTLPConfigs.LogShutdownStats {
+		p.logShutdownStats()
+	}
+
+	return errors.Join(errs...)
+}
+
+// shutdownSignal shuts down the provider of a signal, if it was created.
+func (p *Provider) shutdownSignal(ctx context.Context, signal string) error {
+	switch signal {
+	case SignalTraces:
+		if p.TracerProvider == nil {
+			return nil
+		}
+		if err := p.TracerProvider.Shutdown(ctx); err != nil {
+			if ctx.Err() != nil {
+				p.abandonDrain()
+			}
+			return fmt.Errorf("failed to shutdown tracer provider: %w", err)
+		}
+	case SignalMetrics:
+		if p.MeterProvider == nil {
+			return nil
+		}
+		if err := p.MeterProvider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("failed to shutdown meter provider: %w", err)
+		}
+	case SignalLogs:
+		if p.LoggerProvider == nil {
+			return nil
+		}
+		if err := p.LoggerProvider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("failed to shutdown logger provider: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// parseShutdownOrder validates OTLPConfigs.ShutdownOrder, completing it with the omitted signals
+// in the default order.
+func parseShutdownOrder(signals []string) ([]string, error) {
+	order := make([]string, 0, len(defaultShutdownOrder))
+	for _, signal := range signals {
+		signal = strings.ToLower(strings.TrimSpace(signal))
+		switch signal {
+		case SignalTraces, SignalMetrics, SignalLogs:
+		default:
+			return nil, fmt.Errorf("unknown shutdown order signal %q, expected %q, %q or %q", signal, SignalTraces, SignalMetrics, SignalLogs)
+		}
+		if slices.Contains(order, signal) {
+			return nil, fmt.Errorf("invalid shutdown order: %q is listed twice", signal)
+		}
+		order = append(order, signal)
+	}
+
+	for _, signal := range defaultShutdownOrder {
+		if !slices.Contains(order, signal) {
+			order = append(order, signal)
+		}
+	}
+
+	return order, nil
+}
+
+// logShutdownStats logs the summary of the telemetry exported during the provider lifetime.
+func (p *Provider) logShutdownStats() {
+	if p.spanExporter == nil || p.oversized == nil || p.batch == nil {
+		return
+	}
+
+	stats := p.spanExporter.Stats()
+
+	logger(p.cfgs).Info(
+		"otel telemetry summary",
+		zap.Uint64("exported_spans", stats.ExportedSpans),
+		zap.Uint64("dropped_spans", p.batch.Dropped()),
+		zap.Uint64("failed_spans", stats.FailedSpans),
+		zap.Uint64("oversized_spans", p.oversized.Oversized()),
+		zap.Uint64("bytes_sent", p.monitor.BytesSent()),
+		zap.Duration("uptime", time.Since(p.started)),
+	)
+}
+
+// abandonDrain abandons the pending span exports and logs the number of spans that
+// ended but were not exported.
+func (p *Provider) abandonDrain() {
+	if p.drain == nil {
+		return
+	}
+	p.drain.Abandon()
+
+	exported := p.spanExporter.Stats().ExportedSpans
+	ended := p.endedSpans.count.Load()
+
+	var dropped uint64
+	if ended > exported {
+		dropped = ended - exported
+	}
+
+	logger(p.cfgs).Warn(
+		"otel shutdown deadline exceeded, abandoned pending span exports",
+		zap.Uint64("dropped_spans", dropped),
+	)
+}
+
+// endedSpanCounter is a SpanProcessor counting the sampled spans handed to the exporter.
+type endedSpanCounter struct {
+	count atomic.Uint64
+}
+
+func (c *endedSpanCounter) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+func (c *endedSpanCounter) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		c.count.Add(1)
+	}
+}
+
+func (c *endedSpanCounter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (c *endedSpanCounter) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goxkit/otel/exporter"
+	"github.com/goxkit/otel/processor"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// slowExporter is a span exporter taking a while to export, counting the spans exported once
+// each ExportSpans call returns.
+type slowExporter struct {
+	delay    time.Duration
+	exported atomic.Int64
+}
+
+func (e *slowExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	time.Sleep(e.delay)
+	e.exported.Add(int64(len(spans)))
+	return nil
+}
+
+func (e *slowExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestFlushAndWaitWaitsForConcurrentExports(t *testing.T) {
+	slow := &slowExporter{delay: 200 * time.Millisecond}
+	exports := exporter.NewConcurrent(slow, 4, time.Minute)
+	batch := processor.NewBatch(exports, processor.BatchOptions{})
+
+	p := &Provider{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(batch)),
+		MeterProvider:  sdkmetric.NewMeterProvider(),
+		LoggerProvider: sdklog.NewLoggerProvider(),
+		exports:        exports,
+	}
+	defer func() {
+		_ = p.TracerProvider.Shutdown(context.Background())
+		_ = p.MeterProvider.Shutdown(context.Background())
+		_ = p.LoggerProvider.Shutdown(context.Background())
+	}()
+
+	_, span := p.TracerProvider.Tracer("test").Start(context.Background(), "op")
+	span.End()
+
+	if err := p.FlushAndWait(context.Background()); err != nil {
+		t.Fatalf("FlushAndWait() error = %v, want nil", err)
+	}
+	if got := slow.exported.Load(); got != 1 {
+		t.Errorf("spans exported when FlushAndWait() returned = %d, want 1", got)
+	}
+}
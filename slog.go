@@ -0,0 +1,71 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/logs"
+)
+
+// Console log formats of OTLPConfigs.LogConsoleFormat.
+const (
+	LogConsoleFormatText = "text"
+	LogConsoleFormatJSON = "json"
+)
+
+// SetupWithLogger runs Setup and returns, next to the providers, a ready *slog.Logger handing
+// every record both to stdout and to the OTLP logs pipeline, so that a single call makes a
+// service fully observable. Console records carry the trace_id and span_id of the span active
+// in the context passed to the slog calls (see logs.NewTraceContextHandler), while OTLP records
+// carry the trace context natively.
+//
+// The console format is selected by OTLPConfigs.LogConsoleFormat, "text" (the default) or "json".
+//
+// Parameters:
+//   - ctx: Context used during setup
+//   - cfgs: Application configurations containing the application and OTLP settings
+//
+// Returns:
+//   - *Provider: The configured providers, to be shut down before the application exits
+//   - *slog.Logger: The logger writing to stdout and to the OTLP logs pipeline
+//   - error: Any error encountered during setup
+func SetupWithLogger(ctx context.Context, cfgs *configs.Configs) (*Provider, *slog.Logger, error) {
+	console, err := newConsoleHandler(cfgs, os.Stdout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p, err := Setup(ctx, cfgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	otlp, err := logs.New(cfgs, p.LoggerProvider)
+	if err != nil {
+		_ = p.Shutdown(ctx)
+		return nil, nil, err
+	}
+
+	return p, slog.New(logs.NewFanout(logs.NewTraceContextHandler(console), otlp)), nil
+}
+
+// newConsoleHandler creates the slog handler writing records to w in the
+// OTLPConfigs.LogConsoleFormat format.
+func newConsoleHandler(cfgs *configs.Configs, w io.Writer) (slog.Handler, error) {
+	switch cfgs.OTLPConfigs.LogConsoleFormat {
+	case "", LogConsoleFormatText:
+		return slog.NewTextHandler(w, nil), nil
+	case LogConsoleFormatJSON:
+		return slog.NewJSONHandler(w, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported log console format %q: expected text or json", cfgs.OTLPConfigs.LogConsoleFormat)
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultSpanTimeout is the OTLPConfigs.SpanTimeout registered by Setup, used by StartSpanWithTimeout.
+var defaultSpanTimeout atomic.Int64
+
+// defaultSpanKind is the OTLPConfigs.DefaultSpanKind registered by Setup, used by StartSpan and
+// StartSpanWithTimeout.
+var defaultSpanKind atomic.Int64
+
+// StartSpan starts a span with the package tracer, of the OTLPConfigs.DefaultSpanKind kind
+// registered by Setup (internal when not configured) unless opts set another kind.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - name: The span name
+//   - opts: Options applied to the span
+//
+// Returns:
+//   - context.Context: The context carrying the span
+//   - trace.Span: The started span
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, withDefaultSpanKind(opts)...)
+}
+
+// StartServerSpan starts a span of the server kind with the package tracer, for operations
+// handling a request from a remote client. Backends derive service maps from span kinds, so
+// incoming requests handled without the HTTP middlewares should use it.
+//
+// Parameters:
+//   - ctx: The parent context, typically carrying the trace context extracted from the request
+//   - name: The span name
+//   - opts: Options applied to the span
+//
+// Returns:
+//   - context.Context: The context carrying the span
+//   - trace.Span: The started span
+func StartServerSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, append(slices.Clip(opts), trace.WithSpanKind(trace.SpanKindServer))...)
+}
+
+// StartClientSpan starts a span of the client kind with the package tracer, for calls to a remote
+// service, carrying the server.address and server.port attributes of address, which backends use
+// to draw the edge towards the called service in service maps.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - name: The span name
+//   - address: The address of the called service, as host or host:port
+//   - opts: Options applied to the span
+//
+// Returns:
+//   - context.Context: The context carrying the span
+//   - trace.Span: The started span
+func StartClientSpan(ctx context.Context, name string, address string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	var attrs []attribute.KeyValue
+	if host, port, err := net.SplitHostPort(address); err == nil {
+		attrs = append(attrs, semconv.ServerAddress(host))
+		if p, err := strconv.Atoi(port); err == nil {
+			attrs = append(attrs, semconv.ServerPort(p))
+		}
+	} else if address != "" {
+		attrs = append(attrs, semconv.ServerAddress(address))
+	}
+
+	return Tracer().Start(ctx, name, append(slices.Clip(opts), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))...)
+}
+
+// withDefaultSpanKind prepends the registered default span kind to opts, so that a kind set
+// in opts takes precedence.
+func withDefaultSpanKind(opts []trace.SpanStartOption) []trace.SpanStartOption {
+	kind := trace.SpanKind(defaultSpanKind.Load())
+	if kind == trace.SpanKindUnspecified {
+		return opts
+	}
+
+	return append([]trace.SpanStartOption{trace.WithSpanKind(kind)}, opts...)
+}
+
+// parseSpanKind parses OTLPConfigs.DefaultSpanKind, empty meaning internal.
+func parseSpanKind(kind string) (trace.SpanKind, error) {
+	switch strings.ToLower(kind) {
+	case "", "internal":
+		return trace.SpanKindInternal, nil
+	case "server":
+		return trace.SpanKindServer, nil
+	case "client":
+		return trace.SpanKindClient, nil
+	case "producer":
+		return trace.SpanKindProducer, nil
+	case "consumer":
+		return trace.SpanKindConsumer, nil
+	default:
+		return trace.SpanKindUnspecified, fmt.Errorf("unsupported otel span kind %q: expected internal, server, client, producer or consumer", kind)
+	}
+}
+
+// StartSpanWithTimeout starts a span with the package tracer and returns a context carrying both
+// the span and a deadline, coupling tracing with timeout discipline to prevent runaway operations.
+// Like StartSpan, the span is of the OTLPConfigs.DefaultSpanKind kind unless opts set another.
+// When timeout is not positive, OTLPConfigs.SpanTimeout registered by Setup is used, and no deadline
+// is set when it is not configured either.
+//
+// The returned cancel function cancels the context and ends the span; it must be called once the
+// operation completes and can safely be called several times.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - name: The span name
+//   - timeout: The operation timeout
+//   - opts: Options applied to the span
+//
+// Returns:
+//   - context.Context: The context carrying the span and the deadline
+//   - trace.Span: The started span
+//   - context.CancelFunc: The function cancelling the context and ending the span
+func StartSpanWithTimeout(ctx context.Context, name string, timeout time.Duration, opts ...trace.SpanStartOption) (context.Context, trace.Span, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = time.Duration(defaultSpanTimeout.Load())
+	}
+
+	ctx, span := Tracer().Start(ctx, name, withDefaultSpanKind(opts)...)
+
+	var cancelCtx context.CancelFunc
+	if timeout > 0 {
+		ctx, cancelCtx = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancelCtx = context.WithCancel(ctx)
+	}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			cancelCtx()
+			span.End()
+		})
+	}
+
+	return ctx, span, cancel
+}
+
+// RecordError records err as an exception event on the span and sets the span status to Error
+// with the error message as description. It is a no-op when err is nil.
+//
+// Options are forwarded to span.RecordError, e.g. trace.WithStackTrace(true) to capture the
+// stack trace of the caller or trace.WithAttributes to enrich the exception event.
+//
+// Parameters:
+//   - span: The span the error is recorded on
+//   - err: The error to be recorded
+//   - opts: Options applied to the exception event
+func RecordError(span trace.Span, err error, opts ...trace.EventOption) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err, opts...)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// EndSpan records the error pointed to by err on the span, as RecordError does, and ends the span.
+// It is meant to be deferred with a pointer to the named error result of the function, so that
+// the error actually returned is recorded:
+//
+//	func load(ctx context.Context) (err error) {
+//		ctx, span := otel.Tracer().Start(ctx, "load")
+//		defer otel.EndSpan(span, &err)
+//		...
+//	}
+//
+// The span is ended cleanly when err is nil or points to a nil error.
+//
+// Parameters:
+//   - span: The span to be ended
+//   - err: Pointer to the error result of the function, may be nil
+func EndSpan(span trace.Span, err *error) {
+	if err != nil {
+		RecordError(span, *err)
+	}
+
+	span.End()
+}
+
+// Trace runs fn within a span started with the package tracer as StartSpan does, passing it the
+// context carrying the span, so that the spans started by fn are its children. The error returned
+// by fn is recorded on the span as RecordError does, and the span is ended once fn returns, its
+// duration timing the operation:
+//
+//	err := otel.Trace(ctx, "sync-users", func(ctx context.Context) error {
+//		return syncUsers(ctx)
+//	})
+//
+// A panic of fn is recorded on the span with its stack trace, then propagated once the span has
+// ended.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - name: The span name
+//   - fn: The operation to be traced
+//   - opts: Options applied to the span
+//
+// Returns:
+//   - error: The error returned by fn
+func Trace(ctx context.Context, name string, fn func(ctx context.Context) error, opts ...trace.SpanStartOption) (err error) {
+	ctx, span := StartSpan(ctx, name, opts...)
+	defer func() {
+		if r := recover(); r != nil {
+			RecordError(span, fmt.Errorf("panic: %v", r), trace.WithStackTrace(true))
+			span.End()
+			panic(r)
+		}
+
+		EndSpan(span, &err)
+	}()
+
+	return fn(ctx)
+}
+
+// AddEvent adds an event with the given attributes to the span, converting the Go values of the
+// map into OpenTelemetry attributes: strings, booleans, integers, floats and slices of them are
+// supported, as well as attribute.Value and fmt.Stringer values (recorded as strings). Values of
+// unsupported types are skipped and a warning is logged on the SDK logger configured by Setup.
+// Attributes are added in key order.
+//
+// Parameters:
+//   - span: The span the event is added to
+//   - name: The event name
+//   - attrs: The event attributes
+func AddEvent(span trace.Span, name string, attrs map[string]any) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	kvs := make([]attribute.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		kv, ok := toAttribute(k, attrs[k])
+		if !ok {
+			warn("skipped span event attribute of unsupported type",
+				"event", name, "key", k, "type", fmt.Sprintf("%T", attrs[k]))
+			continue
+		}
+		kvs = append(kvs, kv)
+	}
+
+	span.AddEvent(name, trace.WithAttributes(kvs...))
+}
+
+// toAttribute converts a Go value into an attribute, reporting whether its type is supported.
+func toAttribute(key string, value any) (attribute.KeyValue, bool) {
+	k := attribute.Key(key)
+
+	switch v := value.(type) {
+	case string:
+		return k.String(v), true
+	case bool:
+		return k.Bool(v), true
+	case int:
+		return k.Int(v), true
+	case int8:
+		return k.Int64(int64(v)), true
+	case int16:
+		return k.Int64(int64(v)), true
+	case int32:
+		return k.Int64(int64(v)), true
+	case int64:
+		return k.Int64(v), true
+	case uint8:
+		return k.Int64(int64(v)), true
+	case uint16:
+		return k.Int64(int64(v)), true
+	case uint32:
+		return k.Int64(int64(v)), true
+	case uint:
+		return uintAttribute(k, uint64(v))
+	case uint64:
+		return uintAttribute(k, v)
+	case float32:
+		return k.Float64(float64(v)), true
+	case float64:
+		return k.Float64(v), true
+	case []string:
+		return k.StringSlice(v), true
+	case []bool:
+		return k.BoolSlice(v), true
+	case []int:
+		return k.IntSlice(v), true
+	case []int64:
+		return k.Int64Slice(v), true
+	case []float64:
+		return k.Float64Slice(v), true
+	case attribute.Value:
+		return attribute.KeyValue{Key: k, Value: v}, v.Type() != attribute.INVALID
+	case fmt.Stringer:
+		return k.String(v.String()), true
+	default:
+		return attribute.KeyValue{}, false
+	}
+}
+
+// uintAttribute converts an unsigned integer into an int64 attribute, which can't hold
+// values above math.MaxInt64.
+func uintAttribute(k attribute.Key, v uint64) (attribute.KeyValue, bool) {
+	if v > math.MaxInt64 {
+		return attribute.KeyValue{}, false
+	}
+
+	return k.Int64(int64(v)), true
+}
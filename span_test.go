@@ -0,0 +1,346 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans installs a global TracerProvider recording the ended spans, restoring the previous
+// provider once the test completes.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = tp.Shutdown(context.Background())
+	})
+
+	return recorder
+}
+
+// endedSpan returns the single span ended since the recorder was created.
+func endedSpan(t *testing.T, recorder *tracetest.SpanRecorder) sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("ended spans = %d, want 1", len(spans))
+	}
+	return spans[0]
+}
+
+// attributes returns the attributes as a map, for order-independent comparisons.
+func attributes(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestStartSpanKinds(t *testing.T) {
+	userAttr := attribute.String("user.id", "42")
+
+	tests := []struct {
+		name      string
+		start     func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span)
+		wantKind  trace.SpanKind
+		wantAttrs map[attribute.Key]attribute.Value
+	}{
+		{
+			name: "span",
+			start: func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return StartSpan(ctx, "op", opts...)
+			},
+			wantKind: trace.SpanKindInternal,
+		},
+		{
+			name: "server span",
+			start: func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return StartServerSpan(ctx, "op", opts...)
+			},
+			wantKind: trace.SpanKindServer,
+		},
+		{
+			name: "client span with host and port",
+			start: func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return StartClientSpan(ctx, "op", "users.svc:8080", opts...)
+			},
+			wantKind: trace.SpanKindClient,
+			wantAttrs: map[attribute.Key]attribute.Value{
+				"server.address": attribute.StringValue("users.svc"),
+				"server.port":    attribute.IntValue(8080),
+			},
+		},
+		{
+			name: "client span with host only",
+			start: func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return StartClientSpan(ctx, "op", "users.svc", opts...)
+			},
+			wantKind:  trace.SpanKindClient,
+			wantAttrs: map[attribute.Key]attribute.Value{"server.address": attribute.StringValue("users.svc")},
+		},
+		{
+			name: "client span with a named port",
+			start: func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return StartClientSpan(ctx, "op", "users.svc:http", opts...)
+			},
+			wantKind:  trace.SpanKindClient,
+			wantAttrs: map[attribute.Key]attribute.Value{"server.address": attribute.StringValue("users.svc")},
+		},
+		{
+			name: "client span without address",
+			start: func(ctx context.Context, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return StartClientSpan(ctx, "op", "", opts...)
+			},
+			wantKind: trace.SpanKindClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			// Spare capacity would let an append write into the caller's backing array.
+			opts := make([]trace.SpanStartOption, 1, 4)
+			opts[0] = trace.WithAttributes(userAttr)
+			backing := opts[:cap(opts)]
+
+			_, span := tt.start(context.Background(), opts...)
+			span.End()
+
+			for i, opt := range backing[1:] {
+				if opt != nil {
+					t.Errorf("caller options backing array written at index %d", i+1)
+				}
+			}
+
+			got := endedSpan(t, recorder)
+			if got.SpanKind() != tt.wantKind {
+				t.Errorf("span kind = %v, want %v", got.SpanKind(), tt.wantKind)
+			}
+
+			attrs := attributes(got.Attributes())
+			if attrs[userAttr.Key] != userAttr.Value {
+				t.Errorf("attribute %s = %v, want the caller's %v", userAttr.Key, attrs[userAttr.Key], userAttr.Value)
+			}
+			delete(attrs, userAttr.Key)
+			if len(attrs) != len(tt.wantAttrs) {
+				t.Errorf("attributes = %v, want %v", attrs, tt.wantAttrs)
+			}
+			for k, want := range tt.wantAttrs {
+				if attrs[k] != want {
+					t.Errorf("attribute %s = %v, want %v", k, attrs[k], want)
+				}
+			}
+		})
+	}
+}
+
+func TestTrace(t *testing.T) {
+	errLoad := errors.New("load failed")
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+	}{
+		{name: "success", wantStatus: codes.Unset},
+		{name: "error", err: errLoad, wantStatus: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			var parent trace.SpanContext
+			err := Trace(context.Background(), "sync", func(ctx context.Context) error {
+				parent = trace.SpanContextFromContext(ctx)
+				_, child := StartSpan(ctx, "child")
+				child.End()
+				return tt.err
+			})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Trace() error = %v, want %v", err, tt.err)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 2 {
+				t.Fatalf("ended spans = %d, want the child and the traced span", len(spans))
+			}
+			child, traced := spans[0], spans[1]
+			if traced.Name() != "sync" || traced.SpanContext().SpanID() != parent.SpanID() {
+				t.Errorf("traced span = %s, want the span of the context passed to fn", traced.Name())
+			}
+			if child.Parent().SpanID() != traced.SpanContext().SpanID() {
+				t.Error("span started by fn is not a child of the traced span")
+			}
+			if traced.Status().Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", traced.Status().Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestTracePanic(t *testing.T) {
+	recorder := recordSpans(t)
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want the panic of fn", r)
+			}
+		}()
+
+		_ = Trace(context.Background(), "panics", func(context.Context) error {
+			panic("boom")
+		})
+	}()
+
+	span := endedSpan(t, recorder)
+	if span.Status().Code != codes.Error || span.Status().Description != "panic: boom" {
+		t.Errorf("status = %+v, want an error describing the panic", span.Status())
+	}
+	if events := span.Events(); len(events) != 1 || events[0].Name != "exception" {
+		t.Fatalf("events = %v, want an exception event", events)
+	}
+	if stack := attributes(span.Events()[0].Attributes)["exception.stacktrace"]; stack.AsString() == "" {
+		t.Error("exception event has no stack trace")
+	}
+}
+
+func TestEndSpan(t *testing.T) {
+	errLoad := errors.New("load failed")
+	var nilErr error
+
+	tests := []struct {
+		name       string
+		err        *error
+		wantStatus codes.Code
+		wantEvents int
+	}{
+		{name: "nil pointer", err: nil, wantStatus: codes.Unset},
+		{name: "pointer to nil error", err: &nilErr, wantStatus: codes.Unset},
+		{name: "pointer to error", err: &errLoad, wantStatus: codes.Error, wantEvents: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			_, span := StartSpan(context.Background(), "load")
+			EndSpan(span, tt.err)
+
+			got := endedSpan(t, recorder)
+			if got.Status().Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", got.Status().Code, tt.wantStatus)
+			}
+			if len(got.Events()) != tt.wantEvents {
+				t.Errorf("events = %d, want %d", len(got.Events()), tt.wantEvents)
+			}
+		})
+	}
+}
+
+// stringer is a fmt.Stringer recorded as a string attribute.
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+func TestAddEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  attribute.Value
+		skip  bool
+	}{
+		{name: "string", value: "v", want: attribute.StringValue("v")},
+		{name: "bool", value: true, want: attribute.BoolValue(true)},
+		{name: "int", value: 42, want: attribute.IntValue(42)},
+		{name: "int8", value: int8(-8), want: attribute.Int64Value(-8)},
+		{name: "int16", value: int16(-16), want: attribute.Int64Value(-16)},
+		{name: "int32", value: int32(-32), want: attribute.Int64Value(-32)},
+		{name: "int64", value: int64(-64), want: attribute.Int64Value(-64)},
+		{name: "uint8", value: uint8(8), want: attribute.Int64Value(8)},
+		{name: "uint16", value: uint16(16), want: attribute.Int64Value(16)},
+		{name: "uint32", value: uint32(32), want: attribute.Int64Value(32)},
+		{name: "uint", value: uint(64), want: attribute.Int64Value(64)},
+		{name: "uint64", value: uint64(1 << 62), want: attribute.Int64Value(1 << 62)},
+		{name: "uint64 above MaxInt64", value: uint64(1 << 63), skip: true},
+		{name: "float32", value: float32(0.5), want: attribute.Float64Value(0.5)},
+		{name: "float64", value: 1.5, want: attribute.Float64Value(1.5)},
+		{name: "string slice", value: []string{"a", "b"}, want: attribute.StringSliceValue([]string{"a", "b"})},
+		{name: "bool slice", value: []bool{true, false}, want: attribute.BoolSliceValue([]bool{true, false})},
+		{name: "int slice", value: []int{1, 2}, want: attribute.IntSliceValue([]int{1, 2})},
+		{name: "int64 slice", value: []int64{1, 2}, want: attribute.Int64SliceValue([]int64{1, 2})},
+		{name: "float64 slice", value: []float64{0.5}, want: attribute.Float64SliceValue([]float64{0.5})},
+		{name: "attribute value", value: attribute.IntValue(7), want: attribute.IntValue(7)},
+		{name: "invalid attribute value", value: attribute.Value{}, skip: true},
+		{name: "stringer", value: stringer{}, want: attribute.StringValue("stringer")},
+		{name: "unsupported", value: struct{}{}, skip: true},
+		{name: "nil", value: nil, skip: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			_, span := StartSpan(context.Background(), "op")
+			AddEvent(span, "event", map[string]any{"value": tt.value, "kept": "yes"})
+			span.End()
+
+			events := endedSpan(t, recorder).Events()
+			if len(events) != 1 || events[0].Name != "event" {
+				t.Fatalf("events = %v, want the event added", events)
+			}
+
+			attrs := attributes(events[0].Attributes)
+			if attrs["kept"] != attribute.StringValue("yes") {
+				t.Errorf("attribute kept = %v, want the supported attributes of the event kept", attrs["kept"])
+			}
+			got, ok := attrs["value"]
+			if tt.skip {
+				if ok {
+					t.Errorf("attribute value = %v, want it skipped", got)
+				}
+				return
+			}
+			if !ok || got != tt.want {
+				t.Errorf("attribute value = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddEventKeyOrder(t *testing.T) {
+	recorder := recordSpans(t)
+
+	_, span := StartSpan(context.Background(), "op")
+	AddEvent(span, "event", map[string]any{"c": 3, "a": 1, "b": 2})
+	span.End()
+
+	var keys []attribute.Key
+	for _, kv := range endedSpan(t, recorder).Events()[0].Attributes {
+		keys = append(keys, kv.Key)
+	}
+	if len(keys) != 3 || keys[0] != "a" || keys[1] != "b" || keys[2] != "c" {
+		t.Errorf("attribute keys = %v, want [a b c]", keys)
+	}
+}
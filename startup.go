@@ -0,0 +1,90 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/goxkit/configs"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// StartupSpanName is the name of the span emitted by EmitStartupSpan.
+const StartupSpanName = "service.startup"
+
+// Attribute keys of the span emitted by EmitStartupSpan.
+const (
+	// ConfigHashKey is the SHA-256 hash of the application and OTLP configurations.
+	ConfigHashKey = attribute.Key("service.config.hash")
+	// BootDurationKey is the time in seconds between the process start and the startup span.
+	BootDurationKey = attribute.Key("service.boot.duration")
+)
+
+// processStart approximates the process start time with the package initialization.
+var processStart = time.Now()
+
+// EmitStartupSpan creates and ends a span marking the service boot, carrying the service version,
+// the configuration hash and the boot duration, then flushes the tracer provider so that the span
+// is visible even for short-lived processes. Backends can use it as a deploy marker. The span
+// starts when the process started, so its duration is the boot duration.
+//
+// Setup emits it when OTLPConfigs.StartupSpan is enabled, flushing in background.
+//
+// Parameters:
+//   - ctx: Context bounding the flush
+//   - p: The provider created by Setup
+//
+// Returns:
+//   - error: Any error encountered while flushing the span
+func EmitStartupSpan(ctx context.Context, p *Provider) error {
+	p.startupSpan()
+
+	return p.TracerProvider.ForceFlush(ctx)
+}
+
+// startupSpan creates and ends the span described by EmitStartupSpan.
+func (p *Provider) startupSpan() {
+	now := time.Now()
+
+	attrs := []attribute.KeyValue{
+		ConfigHashKey.String(configHash(p.cfgs)),
+		BootDurationKey.Float64(now.Sub(processStart).Seconds()),
+	}
+	if version, ok := p.resource.Set().Value(semconv.ServiceVersionKey); ok {
+		attrs = append(attrs, semconv.ServiceVersion(version.Emit()))
+	}
+
+	_, span := p.TracerProvider.Tracer(InstrumentationName, trace.WithSchemaURL(SchemaURL())).Start(
+		context.Background(),
+		StartupSpanName,
+		trace.WithTimestamp(processStart),
+		trace.WithAttributes(attrs...),
+	)
+	span.End(trace.WithTimestamp(now))
+}
+
+// configHash returns the hex-encoded SHA-256 hash of the application and OTLP configurations, so
+// that deploys changing the configuration can be told apart without exposing it.
+func configHash(cfgs *configs.Configs) string {
+	encoded, err := json.Marshal(struct {
+		App  *configs.AppConfigs  `json:"app"`
+		OTLP *configs.OTLPConfigs `json:"otlp"`
+	}{cfgs.AppConfigs, cfgs.OTLPConfigs})
+	if err != nil {
+		logger(cfgs).Warn("failed to encode the configurations to hash them", zap.Error(err))
+		return ""
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/otlpgrpc"
+)
+
+// redacted replaces secret configuration values in the status report.
+const redacted = "***"
+
+// Status is the telemetry pipeline status reported by StatusHandler.
+type Status struct {
+	// Connections holds the state of each exporter gRPC connection (IDLE, CONNECTING, READY,
+	// TRANSIENT_FAILURE or SHUTDOWN).
+	Connections []string `json:"connections"`
+	// Spans holds the span export statistics.
+	Spans SpanExportStatus `json:"spans"`
+	// Config is the resolved OTLP configuration, with secrets redacted.
+	Config configs.OTLPConfigs `json:"config"`
+}
+
+// SpanExportStatus holds the span export statistics reported by StatusHandler.
+type SpanExportStatus struct {
+	Exports       uint64    `json:"exports"`
+	Failures      uint64    `json:"failures"`
+	ExportedSpans uint64    `json:"exported_spans"`
+	FailedSpans   uint64    `json:"failed_spans"`
+	LastExport    time.Time `json:"last_export"`
+	LastFailure   time.Time `json:"last_failure"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+// Status returns a snapshot of the telemetry pipeline status.
+func (p *Provider) Status() Status {
+	p.connsMu.Lock()
+	conns := p.conns
+	p.connsMu.Unlock()
+
+	status := Status{
+		Connections: make([]string, 0, len(conns)),
+		Config:      redactedConfigs(p.cfgs),
+	}
+
+	for _, conn := range conns {
+		status.Connections = append(status.Connections, conn.GetState().String())
+	}
+
+	if p.spanExporter != nil {
+		stats := p.spanExporter.Stats()
+		status.Spans = SpanExportStatus{
+			Exports:       stats.Exports,
+			Failures:      stats.Failures,
+			ExportedSpans: stats.ExportedSpans,
+			FailedSpans:   stats.FailedSpans,
+			LastExport:    stats.LastExport,
+			LastFailure:   stats.LastFailure,
+		}
+		if stats.LastError != nil {
+			status.Spans.LastError = stats.LastError.Error()
+		}
+	}
+
+	return status
+}
+
+// LastExportError returns the error of the last span export call and the time it failed, or nil
+// and the zero time when the last call succeeded or no call was made yet, so that a readiness
+// probe can degrade while the telemetry pipeline is failing. It is safe for concurrent use.
+//
+// Returns:
+//   - error: The error of the last export call, nil once an export call succeeds
+//   - time.Time: The time the last export call failed
+func (p *Provider) LastExportError() (error, time.Time) {
+	if p.spanExporter == nil {
+		return nil, time.Time{}
+	}
+
+	stats := p.spanExporter.Stats()
+	if stats.LastError == nil || !stats.LastFailure.After(stats.LastExport) {
+		return nil, time.Time{}
+	}
+
+	return stats.LastError, stats.LastFailure
+}
+
+// StatusHandler returns an http.Handler reporting the telemetry pipeline status of the provider
+// as JSON: the exporter connections state, the span export counts and last export times, and the
+// resolved OTLP configuration with header values redacted. It is meant to be mounted on a debug
+// endpoint such as /debug/otel.
+//
+// Parameters:
+//   - p: The provider created by Setup
+//
+// Returns:
+//   - http.Handler: The status handler
+func StatusHandler(p *Provider) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(p.Status())
+	})
+}
+
+func redactedConfigs(cfgs *configs.Configs) configs.OTLPConfigs {
+	c := *cfgs.OTLPConfigs
+
+	c.ExporterHeaders = redactedHeaders(otlpgrpc.ParseHeaders(c.ExporterHeaders), ",")
+
+	c.TracesFanoutEndpoints = make([]string, 0, len(cfgs.OTLPConfigs.TracesFanoutEndpoints))
+	for _, spec := range cfgs.OTLPConfigs.TracesFanoutEndpoints {
+		endpoint, headers := otlpgrpc.ParseEndpointSpec(spec)
+		if len(headers) > 0 {
+			endpoint += "|" + redactedHeaders(headers, ";")
+		}
+		c.TracesFanoutEndpoints = append(c.TracesFanoutEndpoints, endpoint)
+	}
+
+	return c
+}
+
+// redactedHeaders returns the header keys paired with redacted values, sorted and joined by sep.
+func redactedHeaders(headers map[string]string, sep string) string {
+	pairs := make([]string, 0, len(headers))
+	for key := range headers {
+		pairs = append(pairs, key+"="+redacted)
+	}
+	slices.Sort(pairs)
+
+	return strings.Join(pairs, sep)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DefaultTenantAttribute is the conventional attribute key of the tenant, to be set as
+// OTLPConfigs.TenantAttribute.
+const DefaultTenantAttribute = "tenant.id"
+
+type tenantContextKey struct{}
+
+var tenantKey = struct {
+	sync.RWMutex
+	key any
+}{key: tenantContextKey{}}
+
+// SetTenantContextKey changes the context key the tenant is stored under by WithTenant and read
+// from when stamping telemetry, so that applications already storing the tenant in the context,
+// e.g. from an authentication middleware, don't need to store it twice. The value stored under
+// the key must be a string or a fmt.Stringer. It should be called before Setup.
+//
+// Parameters:
+//   - key: The context key, comparable as required by context.WithValue
+func SetTenantContextKey(key any) {
+	tenantKey.Lock()
+	defer tenantKey.Unlock()
+
+	tenantKey.key = key
+}
+
+// WithTenant returns a copy of ctx carrying the tenant id. When OTLPConfigs.TenantAttribute is set,
+// the spans started and the log records emitted with the returned context carry it.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - id: The tenant id
+//
+// Returns:
+//   - context.Context: The context carrying the tenant id
+func WithTenant(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, currentTenantKey(), id)
+}
+
+// TenantFromContext returns the tenant id carried by ctx, or an empty string when there is none.
+//
+// Parameters:
+//   - ctx: Context carrying the tenant id
+//
+// Returns:
+//   - string: The tenant id
+func TenantFromContext(ctx context.Context) string {
+	switch v := ctx.Value(currentTenantKey()).(type) {
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// TenantAttribute returns the OTLPConfigs.TenantAttribute attribute holding the tenant id carried
+// by ctx, to be recorded with measurements, e.g. counter.Add(ctx, 1,
+// metric.WithAttributes(provider.TenantAttribute(ctx))). Metric views can't read the measurement
+// context, so the tenant is only added to metrics this way.
+//
+// Parameters:
+//   - ctx: Context carrying the tenant id
+//
+// Returns:
+//   - attribute.KeyValue: The tenant attribute
+func (p *Provider) TenantAttribute(ctx context.Context) attribute.KeyValue {
+	key := p.cfgs.OTLPConfigs.TenantAttribute
+	if key == "" {
+		key = DefaultTenantAttribute
+	}
+
+	return attribute.String(key, TenantFromContext(ctx))
+}
+
+func currentTenantKey() any {
+	tenantKey.RLock()
+	defer tenantKey.RUnlock()
+
+	return tenantKey.key
+}
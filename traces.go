@@ -0,0 +1,309 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/exporter"
+	"github.com/goxkit/otel/otlpgrpc"
+	"github.com/goxkit/otel/processor"
+	"github.com/goxkit/otel/redact"
+	"github.com/goxkit/otel/sampler"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// DefaultExportTimeout is the timeout of a single span export call used when
+// OTLPConfigs.ExportTimeout is not set, matching the OpenTelemetry SDK default.
+const DefaultExportTimeout = 30 * time.Second
+
+// Default export retry settings, matching the OpenTelemetry OTLP exporters.
+const (
+	DefaultExportRetryMaxElapsedTime = time.Minute
+	defaultExportRetryInitial        = 5 * time.Second
+	defaultExportRetryMaxInterval    = 30 * time.Second
+)
+
+// NewSpanExporter creates the OTLP span exporter sending spans over the given gRPC connections.
+// When more than one connection is given, export calls are distributed across them in round-robin order.
+//
+// Parameters:
+//   - ctx: Context used to create the exporter
+//   - conns: The gRPC connections to the OTLP collector, as returned by otlpgrpc.NewExporterGRPCClientPool
+//   - opts: Additional exporter options, such as the retry policy
+//
+// Returns:
+//   - sdktrace.SpanExporter: The span exporter
+//   - error: Any error encountered during exporter setup
+func NewSpanExporter(ctx context.Context, conns []*grpc.ClientConn, opts ...otlptracegrpc.Option) (sdktrace.SpanExporter, error) {
+	exporters := make([]sdktrace.SpanExporter, 0, len(conns))
+	for _, conn := range conns {
+		grpcExporter, err := otlptracegrpc.New(ctx, append([]otlptracegrpc.Option{otlptracegrpc.WithGRPCConn(conn)}, opts...)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp trace exporter: %w", err)
+		}
+
+		exporters = append(exporters, grpcExporter)
+	}
+
+	return exporter.NewRoundRobin(exporters...), nil
+}
+
+// NewHTTPSpanExporter creates the OTLP/HTTP span exporter used when OTLPConfigs.TracesProtocol is
+// "http". It sends spans to OTLPConfigs.TracesEndpoint, or OTLPConfigs.Endpoint when unset, written
+// either as host:port, the default "/v1/traces" path being used and TLS following
+// OTLPConfigs.ExporterTLSEnabled, or as a full URL. The configured headers, gzip compression
+// (OTLPConfigs.TracesCompression, defaulting to OTLPConfigs.ExporterCompression) and retry budget
+// (see SpanExportRetryOption) apply.
+//
+// Parameters:
+//   - ctx: Context used to create the exporter
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - sdktrace.SpanExporter: The span exporter
+//   - error: Any error encountered during exporter setup
+func NewHTTPSpanExporter(ctx context.Context, cfgs *configs.Configs) (sdktrace.SpanExporter, error) {
+	retry := exportRetry(cfgs)
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithHeaders(otlpgrpc.ParseHeaders(cfgs.OTLPConfigs.ExporterHeaders)),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig(retry)),
+	}
+
+	endpoint := newHTTPEndpoint(cfgs, signalEndpoint(cfgs, cfgs.OTLPConfigs.TracesEndpoint))
+	switch {
+	case endpoint.url != "":
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint.url))
+	case endpoint.insecure:
+		opts = append(opts, otlptracehttp.WithEndpoint(endpoint.hostPort), otlptracehttp.WithInsecure())
+	default:
+		opts = append(opts, otlptracehttp.WithEndpoint(endpoint.hostPort))
+	}
+
+	if signalCompression(cfgs, cfgs.OTLPConfigs.TracesCompression) == otlpgrpc.CompressionGzip {
+		opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+	}
+
+	httpExporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp http trace exporter: %w", err)
+	}
+
+	return httpExporter, nil
+}
+
+// SpanExportRetryOption returns the retry policy of the OTLP/gRPC span exporter bounding the
+// retries of a failed batch to OTLPConfigs.ExportRetryMaxElapsedTime
+// (DefaultExportRetryMaxElapsedTime when unset, a negative value disabling retries). Once the
+// budget is spent, the batch is dropped and counted by the otel.exporter.span.failed counter
+// registered by Setup, which bounds memory growth during long collector outages.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - otlptracegrpc.Option: The retry option, to be passed to NewSpanExporter
+func SpanExportRetryOption(cfgs *configs.Configs) otlptracegrpc.Option {
+	return otlptracegrpc.WithRetry(exportRetry(cfgs))
+}
+
+// exportRetry returns the retry policy described by SpanExportRetryOption, the backoff
+// intervals being capped by the budget so that a small budget still allows a retry.
+func exportRetry(cfgs *configs.Configs) otlptracegrpc.RetryConfig {
+	budget := cfgs.OTLPConfigs.ExportRetryMaxElapsedTime
+	if budget < 0 {
+		return otlptracegrpc.RetryConfig{Enabled: false}
+	}
+	if budget == 0 {
+		budget = DefaultExportRetryMaxElapsedTime
+	}
+
+	return otlptracegrpc.RetryConfig{
+		Enabled:         true,
+		InitialInterval: min(defaultExportRetryInitial, budget),
+		MaxInterval:     min(defaultExportRetryMaxInterval, budget),
+		MaxElapsedTime:  budget,
+	}
+}
+
+// NewHTTPFallbackSpanExporter creates the OTLP/HTTP span exporter used as fallback when the gRPC
+// collector endpoint is unreachable. It sends spans to OTLPConfigs.ExporterHTTPFallbackEndpoint,
+// a full URL such as "https://collector:4318/v1/traces" (TLS is disabled for http URLs), with the
+// configured exporter headers.
+//
+// Parameters:
+//   - ctx: Context used to create the exporter
+//   - cfgs: Application configurations containing OTLP settings
+//
+// Returns:
+//   - sdktrace.SpanExporter: The span exporter
+//   - error: Any error encountered during exporter setup
+func NewHTTPFallbackSpanExporter(ctx context.Context, cfgs *configs.Configs) (sdktrace.SpanExporter, error) {
+	httpExporter, err := otlptracehttp.New(
+		ctx,
+		otlptracehttp.WithEndpointURL(cfgs.OTLPConfigs.ExporterHTTPFallbackEndpoint),
+		otlptracehttp.WithHeaders(otlpgrpc.ParseHeaders(cfgs.OTLPConfigs.ExporterHeaders)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp http fallback trace exporter: %w", err)
+	}
+
+	return httpExporter, nil
+}
+
+// NewTracerProvider creates a TracerProvider exporting spans through a batch span processor,
+// preceded by the span processors and sampled by the sampler described in the configurations.
+// The options are described in the Configuration Options section of the README.
+//
+// Parameters:
+//   - cfgs: Application configurations containing OTLP settings
+//   - spanExporter: The exporter spans are sent to, typically created by NewSpanExporter
+//   - res: The resource describing the application, as returned by NewResource
+//
+// Returns:
+//   - *sdktrace.TracerProvider: The configured tracer provider
+//   - error: An error if the span processing configuration is invalid
+func NewTracerProvider(cfgs *configs.Configs, spanExporter sdktrace.SpanExporter, res *resource.Resource) (*sdktrace.TracerProvider, error) {
+	tp, _, err := newTracerProvider(cfgs, spanExporter, res, nil)
+	return tp, err
+}
+
+// newTracerProvider creates the TracerProvider as NewTracerProvider, deciding root spans with
+// base (see sampler.New), and also returns its batch processor so that Setup can observe the
+// span queue.
+func newTracerProvider(cfgs *configs.Configs, spanExporter sdktrace.SpanExporter, res *resource.Resource, base sdktrace.Sampler) (*sdktrace.TracerProvider, *processor.Batch, error) {
+	sp, batch, err := newSpanProcessor(cfgs, spanExporter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler.New(cfgs, base)),
+		sdktrace.WithSpanProcessor(sp),
+	}
+	if generator := currentIDGenerator(); generator != nil {
+		opts = append(opts, sdktrace.WithIDGenerator(generator))
+	}
+
+	return sdktrace.NewTracerProvider(opts...), batch, nil
+}
+
+// newSpanProcessor builds the span processing pipeline: the batch span processor
+// exporting to spanExporter, preceded by the configured processors. The clock skew
+// correction wraps every processor but the watchdog, so that they see corrected timestamps.
+func newSpanProcessor(cfgs *configs.Configs, spanExporter sdktrace.SpanExporter) (sdktrace.SpanProcessor, *processor.Batch, error) {
+	rules, err := processor.ParseSpanNameRules(cfgs.OTLPConfigs.SpanNameRules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch cfgs.OTLPConfigs.OnQueueFull {
+	case "", processor.QueueFullDrop, processor.QueueFullBlock:
+	default:
+		return nil, nil, fmt.Errorf("unknown queue full behavior %q, expected %q or %q", cfgs.OTLPConfigs.OnQueueFull, processor.QueueFullDrop, processor.QueueFullBlock)
+	}
+
+	batch := processor.NewBatch(spanExporter, processor.BatchOptions{
+		ExportTimeout:   exportTimeout(cfgs),
+		ExportOnCount:   cfgs.OTLPConfigs.ExportOnCount,
+		OnQueueFull:     cfgs.OTLPConfigs.OnQueueFull,
+		MaxBlockTime:    cfgs.OTLPConfigs.QueueFullMaxBlockTime,
+		ProfilingLabels: cfgs.OTLPConfigs.ProfilingLabels,
+	})
+
+	redactor, err := newRedactor(cfgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sp sdktrace.SpanProcessor = processor.NewSpanNameNormalizer(batch, rules)
+	sp = processor.NewRedactor(sp, redactor)
+	sp = processor.NewDedup(sp, processor.DedupOptions{
+		Window:          cfgs.OTLPConfigs.SpanDedupWindow,
+		KeyAttributes:   attributeKeys(cfgs.OTLPConfigs.SpanDedupKeyAttributes),
+		ProfilingLabels: cfgs.OTLPConfigs.ProfilingLabels,
+	})
+	sp = processor.NewMinDuration(sp, cfgs.OTLPConfigs.MinSpanDuration)
+	if cfgs.OTLPConfigs.TailSamplingWindow > 0 {
+		sp = processor.NewTailSampler(sp, tailSamplerOptions(cfgs))
+	}
+	if cfgs.OTLPConfigs.AlwaysSampleErrors {
+		sp = processor.NewErrorBiased(sp, processor.TailSamplerOptions{
+			Window:          cfgs.OTLPConfigs.AlwaysSampleErrorsWindow,
+			MaxTraces:       cfgs.OTLPConfigs.AlwaysSampleErrorsMaxTraces,
+			ProfilingLabels: cfgs.OTLPConfigs.ProfilingLabels,
+		})
+	}
+	if cfgs.OTLPConfigs.SpanCallerInfo {
+		sp = processor.NewCallerInfo(sp)
+	}
+	if cfgs.OTLPConfigs.TenantAttribute != "" {
+		sp = processor.NewContextAttribute(sp, attribute.Key(cfgs.OTLPConfigs.TenantAttribute), TenantFromContext)
+	}
+	sp = processor.NewStaticAttributes(sp, signalAttributes(cfgs.OTLPConfigs.TraceAttributes)...)
+	sp = processor.NewClockSkew(sp, processor.ClockSkewOptions{
+		MaxSkew: cfgs.OTLPConfigs.MaxClockSkew,
+		OnClamp: func(s sdktrace.ReadOnlySpan) {
+			logger(cfgs).Warn(
+				"clamped the timestamps of a span, the host clock may be drifting",
+				zap.String("span", s.Name()),
+				zap.Time("start", s.StartTime()),
+				zap.Time("end", s.EndTime()),
+			)
+		},
+	})
+	if cfgs.OTLPConfigs.MaxSpanDuration > 0 {
+		sp = processor.NewWatchdog(sp, processor.WatchdogOptions{
+			MaxDuration:     cfgs.OTLPConfigs.MaxSpanDuration,
+			ProfilingLabels: cfgs.OTLPConfigs.ProfilingLabels,
+		})
+	}
+
+	return sp, batch, nil
+}
+
+// tailSamplerOptions keeps the traces containing an error and, when
+// OTLPConfigs.TailSamplingLatencyThreshold is set, the traces containing a slower span.
+func tailSamplerOptions(cfgs *configs.Configs) processor.TailSamplerOptions {
+	rules := []processor.TailRule{processor.KeepErrors()}
+	if cfgs.OTLPConfigs.TailSamplingLatencyThreshold > 0 {
+		rules = append(rules, processor.KeepSlowerThan(cfgs.OTLPConfigs.TailSamplingLatencyThreshold))
+	}
+
+	return processor.TailSamplerOptions{
+		Window:          cfgs.OTLPConfigs.TailSamplingWindow,
+		MaxTraces:       cfgs.OTLPConfigs.TailSamplingMaxTraces,
+		Rules:           rules,
+		ProfilingLabels: cfgs.OTLPConfigs.ProfilingLabels,
+	}
+}
+
+func exportTimeout(cfgs *configs.Configs) time.Duration {
+	if cfgs.OTLPConfigs.ExportTimeout > 0 {
+		return cfgs.OTLPConfigs.ExportTimeout
+	}
+
+	return DefaultExportTimeout
+}
+
+// newRedactor creates the redactor applying the OTLPConfigs.RedactAttributes rules, nil when there is none.
+func newRedactor(cfgs *configs.Configs) (*redact.Redactor, error) {
+	rules, err := redact.ParseRules(cfgs.OTLPConfigs.RedactAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return redact.New(rules), nil
+}